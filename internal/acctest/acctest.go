@@ -54,6 +54,7 @@ import (
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fakeaws"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest/jsoncmp"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/dns"
@@ -313,6 +314,18 @@ func PreCheck(ctx context.Context, t *testing.T) {
 	// Since we are outside the scope of the Terraform configuration we must
 	// call Configure() to properly initialize the provider configuration.
 	testAccProviderConfigure.Do(func() {
+		if fakeaws.IsEnabled() {
+			os.Setenv(envvar.DefaultRegion, Region())
+
+			Provider.TerraformVersion = "1.0.0"
+			diags := Provider.Configure(ctx, terraformsdk.NewResourceConfigRaw(fakeAWSProviderConfig()))
+			if err := sdkdiag.DiagnosticsError(diags); err != nil {
+				t.Fatalf("configuring provider for fake AWS: %s", err)
+			}
+
+			return
+		}
+
 		envvar.FailIfAllEmpty(t, []string{envvar.Profile, envvar.AccessKeyId, envvar.ContainerCredentialsFullURI}, "credentials for running acceptance testing")
 
 		if os.Getenv(envvar.AccessKeyId) != "" {
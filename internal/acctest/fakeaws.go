@@ -0,0 +1,86 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fakeaws"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+)
+
+const (
+	fakeAWSAccessKey = "fakeaws"
+	fakeAWSSecretKey = "fakeaws"
+)
+
+// fakeAWSServer returns the fake AWS server shared by all tests in the process.
+// Sharing a single server lets the provider instance configured by PreCheck (used by CheckDestroy functions)
+// see the same state as the provider instances under test.
+var fakeAWSServer = sync.OnceValue(fakeaws.NewServer)
+
+// fakeAWSProviderConfig returns the provider configuration pointing at the fake AWS server.
+func fakeAWSProviderConfig() map[string]any {
+	return map[string]any{
+		"access_key":              fakeAWSAccessKey,
+		"secret_key":              fakeAWSSecretKey,
+		"skip_metadata_api_check": "true",
+		"endpoints":               []any{fakeAWSEndpoints()},
+	}
+}
+
+func fakeAWSEndpoints() map[string]any {
+	endpoints := make(map[string]any)
+	for k, v := range fakeAWSServer().Endpoints() {
+		endpoints[k] = v
+	}
+
+	return endpoints
+}
+
+// fakeAWSEnabledProtoV5ProviderFactories returns ProtoV5ProviderFactories ready for use
+// with the fake AWS server
+func fakeAWSEnabledProtoV5ProviderFactories(ctx context.Context, t *testing.T, input map[string]func() (tfprotov5.ProviderServer, error)) map[string]func() (tfprotov5.ProviderServer, error) {
+	t.Helper()
+
+	output := make(map[string]func() (tfprotov5.ProviderServer, error), len(input))
+
+	for name := range input {
+		output[name] = func() (tfprotov5.ProviderServer, error) {
+			providerServerFactory, primary, err := provider.ProtoV5ProviderServerFactory(ctx)
+
+			if err != nil {
+				return nil, err
+			}
+
+			primary.ConfigureContextFunc = fakeAWSProviderConfigureContextFunc(primary.ConfigureContextFunc)
+
+			return providerServerFactory(), nil
+		}
+	}
+
+	return output
+}
+
+// fakeAWSProviderConfigureContextFunc returns a provider configuration function that
+// overrides credentials and endpoints so that all API calls are served by the fake AWS server
+func fakeAWSProviderConfigureContextFunc(configureContextFunc schema.ConfigureContextFunc) schema.ConfigureContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData) (any, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		for k, v := range fakeAWSProviderConfig() {
+			if err := d.Set(k, v); err != nil {
+				return nil, sdkdiag.AppendErrorf(diags, "configuring fake AWS (%s): %s", k, err)
+			}
+		}
+
+		return configureContextFunc(ctx, d)
+	}
+}
@@ -0,0 +1,245 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Package fakeaws implements an in-process, stateful stand-in for AWS service endpoints.
+//
+// Service packages register simple handlers for the operations that their resources use,
+// typically from a test init function:
+//
+//	func init() {
+//		fakeaws.RegisterService(names.SSM, newFakeService)
+//	}
+//
+// When fake AWS testing is enabled, acctest.ParallelTest and acctest.Test point the provider's endpoints at a
+// single Server shared by all tests in the process, so that resource lifecycles can be exercised without an AWS account.
+// Service state is therefore shared between tests, which must use unique resource names as they do against AWS.
+package fakeaws
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/http"
+	"os"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+)
+
+const (
+	envVarFakeAWS = "TF_ACC_FAKE_AWS"
+)
+
+const (
+	// AccountID is the AWS account ID of the fake AWS caller.
+	AccountID = "123456789012"
+	// Partition is the AWS partition of the fake AWS endpoints.
+	Partition = "aws"
+)
+
+// IsEnabled indicates whether fake AWS testing is enabled
+//
+// Returns true if the TF_ACC_FAKE_AWS environment variable is set to a non-empty value.
+func IsEnabled() bool {
+	return os.Getenv(envVarFakeAWS) != ""
+}
+
+// Operation handles a single AWS API operation.
+//
+// The input is the decoded request: the JSON object for JSON protocols, or the request parameters for the Query protocol,
+// with list members ("Name.member.N") gathered into lists.
+// The output is encoded according to the request's protocol. Output values may be strings, booleans, numbers,
+// time.Time, []any or map[string]any.
+type Operation func(ctx context.Context, input map[string]any) (map[string]any, error)
+
+// Operations maps an API operation name, e.g. "PutParameter", to its handler.
+type Operations map[string]Operation
+
+var services map[string]func() Operations
+
+// RegisterService registers a fake AWS service.
+// name is the service package name, e.g. names.SSM, and is used as the key into the provider's endpoints.
+// f is called once per Server to create handlers with their own state; tests share the process-wide Server's state.
+// Calls to a Server's handlers are serialized, so handlers need not synchronize access to their state.
+func RegisterService(name string, f func() Operations) {
+	if services == nil {
+		services = make(map[string]func() Operations)
+	}
+
+	if _, ok := services[name]; ok {
+		panic(fmt.Sprintf("Cannot re-register a service! fakeaws.Service exists for %s", name)) //lintignore:R009
+	}
+
+	services[name] = f
+}
+
+// Error is an AWS API error returned by an Operation.
+type Error struct {
+	Code       string
+	Message    string
+	StatusCode int
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+// NewError returns a new client (HTTP 400) error with the specified code.
+func NewError(code, format string, a ...any) *Error {
+	return &Error{
+		Code:       code,
+		Message:    fmt.Sprintf(format, a...),
+		StatusCode: http.StatusBadRequest,
+	}
+}
+
+// NewNotFoundError returns a new HTTP 404 error with the specified code.
+func NewNotFoundError(code, format string, a ...any) *Error {
+	return &Error{
+		Code:       code,
+		Message:    fmt.Sprintf(format, a...),
+		StatusCode: http.StatusNotFound,
+	}
+}
+
+type contextKeyType int
+
+var (
+	regionContextKey contextKeyType
+)
+
+// Region returns the AWS Region of the current request.
+func Region(ctx context.Context) string {
+	if v, ok := ctx.Value(regionContextKey).(string); ok {
+		return v
+	}
+
+	return ""
+}
+
+// ARN returns an ARN for the specified service and resource in the current request's Region and the fake AWS account.
+func ARN(ctx context.Context, service, resource string) string {
+	return arn.ARN{
+		Partition: Partition,
+		Service:   service,
+		Region:    Region(ctx),
+		AccountID: AccountID,
+		Resource:  resource,
+	}.String()
+}
+
+// GlobalARN returns an ARN for the specified global service (e.g. IAM) and resource in the fake AWS account.
+func GlobalARN(service, resource string) string {
+	return arn.ARN{
+		Partition: Partition,
+		Service:   service,
+		AccountID: AccountID,
+		Resource:  resource,
+	}.String()
+}
+
+// Now returns the current time, truncated to the precision of AWS API timestamps.
+func Now() time.Time {
+	return time.Now().UTC().Truncate(time.Second)
+}
+
+// String returns the string value of the specified input parameter, or "" if not present.
+func String(input map[string]any, key string) string {
+	switch v := input[key].(type) {
+	case string:
+		return v
+	case nil:
+		return ""
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// Bool returns the boolean value of the specified input parameter, or false if not present.
+func Bool(input map[string]any, key string) bool {
+	switch v := input[key].(type) {
+	case bool:
+		return v
+	case string:
+		b, _ := strconv.ParseBool(v)
+		return b
+	default:
+		return false
+	}
+}
+
+// Int returns the integer value of the specified input parameter, or 0 if not present.
+func Int(input map[string]any, key string) int64 {
+	switch v := input[key].(type) {
+	case float64:
+		return int64(v)
+	case string:
+		i, _ := strconv.ParseInt(v, 10, 64)
+		return i
+	default:
+		return 0
+	}
+}
+
+// List returns the list value of the specified input parameter, or nil if not present.
+func List(input map[string]any, key string) []any {
+	v, _ := input[key].([]any)
+	return v
+}
+
+// Map returns the object value of the specified input parameter, or nil if not present.
+func Map(input map[string]any, key string) map[string]any {
+	v, _ := input[key].(map[string]any)
+	return v
+}
+
+// Tags holds the tags of a fake resource.
+type Tags map[string]string
+
+// AddList adds tags from a list of {"Key": ..., "Value": ...} objects.
+func (t Tags) AddList(tags []any) {
+	for _, v := range tags {
+		if v, ok := v.(map[string]any); ok {
+			t[String(v, "Key")] = String(v, "Value")
+		}
+	}
+}
+
+// AddMap adds tags from a key-value object.
+func (t Tags) AddMap(tags map[string]any) {
+	for k, v := range tags {
+		t[k] = fmt.Sprint(v)
+	}
+}
+
+// Remove removes the tags with the specified keys.
+func (t Tags) Remove(keys []any) {
+	for _, k := range keys {
+		delete(t, fmt.Sprint(k))
+	}
+}
+
+// List returns the tags as a list of {"Key": ..., "Value": ...} objects, ordered by key.
+func (t Tags) List() []any {
+	tags := make([]any, 0, len(t))
+	for _, k := range slices.Sorted(maps.Keys(t)) {
+		tags = append(tags, map[string]any{
+			"Key":   k,
+			"Value": t[k],
+		})
+	}
+
+	return tags
+}
+
+// Map returns the tags as a key-value object.
+func (t Tags) Map() map[string]any {
+	tags := make(map[string]any, len(t))
+	for k, v := range t {
+		tags[k] = v
+	}
+
+	return tags
+}
@@ -0,0 +1,179 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fakeaws"
+)
+
+const (
+	testJSONService  = "fakeawstestjson"
+	testQueryService = "fakeawstestquery"
+)
+
+func init() {
+	fakeaws.RegisterService(testJSONService, func() fakeaws.Operations {
+		parameters := make(map[string]string)
+
+		return fakeaws.Operations{
+			"PutParameter": func(_ context.Context, input map[string]any) (map[string]any, error) {
+				parameters[fakeaws.String(input, "Name")] = fakeaws.String(input, "Value")
+				return map[string]any{"Version": 1}, nil
+			},
+			"GetParameter": func(ctx context.Context, input map[string]any) (map[string]any, error) {
+				name := fakeaws.String(input, "Name")
+				value, ok := parameters[name]
+				if !ok {
+					return nil, fakeaws.NewError("ParameterNotFound", "%s", name)
+				}
+				return map[string]any{
+					"Parameter": map[string]any{
+						"ARN":              fakeaws.ARN(ctx, "ssm", "parameter/"+name),
+						"LastModifiedDate": fakeaws.Now(),
+						"Name":             name,
+						"Value":            value,
+					},
+				}, nil
+			},
+		}
+	})
+	fakeaws.RegisterService(testQueryService, func() fakeaws.Operations {
+		return fakeaws.Operations{
+			"ListRoleTags": func(_ context.Context, input map[string]any) (map[string]any, error) {
+				if name := fakeaws.String(input, "RoleName"); name != "test" {
+					return nil, fakeaws.NewNotFoundError("NoSuchEntity", "The role with name %s cannot be found.", name)
+				}
+				return map[string]any{
+					"IsTruncated": false,
+					"Tags": []any{
+						map[string]any{"Key": "k1", "Value": "v1"},
+						map[string]any{"Key": "k2", "Value": "v2"},
+					},
+				}, nil
+			},
+		}
+	})
+}
+
+func testConfig(region string) aws.Config {
+	return aws.Config{
+		Credentials: credentials.NewStaticCredentialsProvider("fakeaws", "fakeaws", ""),
+		Region:      region,
+	}
+}
+
+func TestServer_jsonProtocol(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server := fakeaws.NewServer()
+	t.Cleanup(server.Close)
+
+	conn := ssm.NewFromConfig(testConfig("eu-west-1"), func(o *ssm.Options) {
+		o.BaseEndpoint = aws.String(server.Endpoints()[testJSONService])
+	})
+
+	_, err := conn.GetParameter(ctx, &ssm.GetParameterInput{Name: aws.String("test")})
+	if nf := (*ssmtypes.ParameterNotFound)(nil); !errors.As(err, &nf) {
+		t.Fatalf("GetParameter error = %v, want ParameterNotFound", err)
+	}
+
+	if _, err := conn.PutParameter(ctx, &ssm.PutParameterInput{Name: aws.String("test"), Value: aws.String("v1")}); err != nil {
+		t.Fatalf("PutParameter: %s", err)
+	}
+
+	output, err := conn.GetParameter(ctx, &ssm.GetParameterInput{Name: aws.String("test")})
+	if err != nil {
+		t.Fatalf("GetParameter: %s", err)
+	}
+
+	if got, want := aws.ToString(output.Parameter.Value), "v1"; got != want {
+		t.Errorf("Value = %q, want %q", got, want)
+	}
+	if got, want := aws.ToString(output.Parameter.ARN), "arn:aws:ssm:eu-west-1:123456789012:parameter/test"; got != want {
+		t.Errorf("ARN = %q, want %q", got, want)
+	}
+	if output.Parameter.LastModifiedDate == nil || output.Parameter.LastModifiedDate.IsZero() {
+		t.Errorf("LastModifiedDate not set")
+	}
+
+	// State is not shared between servers.
+	other := fakeaws.NewServer()
+	t.Cleanup(other.Close)
+
+	conn = ssm.NewFromConfig(testConfig("eu-west-1"), func(o *ssm.Options) {
+		o.BaseEndpoint = aws.String(other.Endpoints()[testJSONService])
+	})
+
+	_, err = conn.GetParameter(ctx, &ssm.GetParameterInput{Name: aws.String("test")})
+	if nf := (*ssmtypes.ParameterNotFound)(nil); !errors.As(err, &nf) {
+		t.Fatalf("GetParameter error = %v, want ParameterNotFound", err)
+	}
+}
+
+func TestServer_queryProtocol(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server := fakeaws.NewServer()
+	t.Cleanup(server.Close)
+
+	conn := iam.NewFromConfig(testConfig("us-east-1"), func(o *iam.Options) {
+		o.BaseEndpoint = aws.String(server.Endpoints()[testQueryService])
+	})
+
+	output, err := conn.ListRoleTags(ctx, &iam.ListRoleTagsInput{RoleName: aws.String("test")})
+	if err != nil {
+		t.Fatalf("ListRoleTags: %s", err)
+	}
+
+	if got, want := len(output.Tags), 2; got != want {
+		t.Fatalf("len(Tags) = %d, want %d", got, want)
+	}
+	if got, want := aws.ToString(output.Tags[1].Value), "v2"; got != want {
+		t.Errorf("Tags[1].Value = %q, want %q", got, want)
+	}
+
+	_, err = conn.ListRoleTags(ctx, &iam.ListRoleTagsInput{RoleName: aws.String("other")})
+	if apiErr := smithy.APIError(nil); !errors.As(err, &apiErr) || apiErr.ErrorCode() != "NoSuchEntity" {
+		t.Errorf("ListRoleTags error = %v, want NoSuchEntity", err)
+	}
+
+	_, err = conn.GetRole(ctx, &iam.GetRoleInput{RoleName: aws.String("test")})
+	if apiErr := smithy.APIError(nil); !errors.As(err, &apiErr) || apiErr.ErrorCode() != "InvalidAction" {
+		t.Errorf("GetRole error = %v, want InvalidAction", err)
+	}
+}
+
+func TestServer_sts(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server := fakeaws.NewServer()
+	t.Cleanup(server.Close)
+
+	conn := sts.NewFromConfig(testConfig("us-west-2"), func(o *sts.Options) {
+		o.BaseEndpoint = aws.String(server.Endpoints()["sts"])
+	})
+
+	output, err := conn.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		t.Fatalf("GetCallerIdentity: %s", err)
+	}
+
+	if got, want := aws.ToString(output.Account), fakeaws.AccountID; got != want {
+		t.Errorf("Account = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,288 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
+)
+
+// Server is a fake AWS endpoint serving all registered services.
+// Each service is served below its own path, e.g. "/ssm/".
+type Server struct {
+	server     *httptest.Server
+	mu         sync.Mutex
+	operations map[string]Operations
+}
+
+// NewServer starts and returns a new Server.
+// The caller should call Close when finished, to shut it down.
+func NewServer() *Server {
+	s := &Server{
+		operations: make(map[string]Operations, len(services)),
+	}
+
+	for name, f := range services {
+		s.operations[name] = f()
+	}
+
+	s.server = httptest.NewServer(s)
+
+	return s
+}
+
+// Close shuts down the server.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Endpoints returns the endpoint URL of each registered service, keyed by service package name.
+// The result is suitable for use as the provider's "endpoints" configuration.
+func (s *Server) Endpoints() map[string]string {
+	endpoints := make(map[string]string, len(s.operations))
+
+	for name := range s.operations {
+		endpoints[name] = s.server.URL + "/" + name
+	}
+
+	return endpoints
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	name, _, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var p protocol
+	if target := r.Header.Get("X-Amz-Target"); target != "" {
+		p = &jsonProtocol{target: target, contentType: r.Header.Get("Content-Type")}
+	} else {
+		p = &queryProtocol{}
+	}
+
+	operation, input, err := p.decode(body)
+	if err != nil {
+		p.encodeError(w, NewError("SerializationException", "%s", err))
+		return
+	}
+
+	f, ok := s.operations[name][operation]
+	if !ok {
+		p.encodeError(w, NewError("InvalidAction", "fakeaws: %s operation %s is not implemented", name, operation))
+		return
+	}
+
+	ctx := context.WithValue(r.Context(), regionContextKey, signingRegion(r))
+
+	s.mu.Lock()
+	output, err := f(ctx, input)
+	s.mu.Unlock()
+
+	if err != nil {
+		p.encodeError(w, err)
+		return
+	}
+
+	p.encode(w, operation, output)
+}
+
+// signingRegion returns the Region from the request's Signature Version 4 credential scope.
+func signingRegion(r *http.Request) string {
+	// Authorization: AWS4-HMAC-SHA256 Credential=AKIA.../20250101/us-west-2/ssm/aws4_request, SignedHeaders=..., Signature=...
+	_, credential, ok := strings.Cut(r.Header.Get("Authorization"), "Credential=")
+	if !ok {
+		return ""
+	}
+
+	credential, _, _ = strings.Cut(credential, ",")
+	if parts := strings.Split(credential, "/"); len(parts) == 5 {
+		return parts[2]
+	}
+
+	return ""
+}
+
+type protocol interface {
+	decode([]byte) (string, map[string]any, error)
+	encode(http.ResponseWriter, string, map[string]any)
+	encodeError(http.ResponseWriter, error)
+}
+
+// jsonProtocol implements the AWS JSON 1.0 and 1.1 protocols.
+type jsonProtocol struct {
+	target      string
+	contentType string
+}
+
+func (p *jsonProtocol) decode(body []byte) (string, map[string]any, error) {
+	_, operation, _ := strings.Cut(p.target, ".")
+
+	input := make(map[string]any)
+	if len(body) > 0 {
+		if err := json.Unmarshal(body, &input); err != nil {
+			return "", nil, err
+		}
+	}
+
+	return operation, input, nil
+}
+
+func (p *jsonProtocol) encode(w http.ResponseWriter, _ string, output map[string]any) {
+	if output == nil {
+		output = make(map[string]any)
+	}
+
+	b, err := json.Marshal(toJSON(output))
+	if err != nil {
+		p.encodeError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", p.contentType)
+	w.WriteHeader(http.StatusOK)
+	w.Write(b)
+}
+
+func (p *jsonProtocol) encodeError(w http.ResponseWriter, err error) {
+	e := asError(err)
+	b, _ := json.Marshal(map[string]string{
+		"__type":  e.Code,
+		"message": e.Message,
+	})
+
+	w.Header().Set("Content-Type", p.contentType)
+	w.Header().Set("X-Amzn-Errortype", e.Code)
+	w.WriteHeader(e.StatusCode)
+	w.Write(b)
+}
+
+func toJSON(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		m := make(map[string]any, len(v))
+		for k, v := range v {
+			m[k] = toJSON(v)
+		}
+		return m
+	case []any:
+		l := make([]any, len(v))
+		for i, v := range v {
+			l[i] = toJSON(v)
+		}
+		return l
+	case time.Time:
+		// Epoch seconds.
+		return float64(v.UnixMilli()) / 1000
+	default:
+		return v
+	}
+}
+
+// queryProtocol implements the AWS Query protocol.
+type queryProtocol struct{}
+
+func (p *queryProtocol) decode(body []byte) (string, map[string]any, error) {
+	values, err := url.ParseQuery(string(body))
+	if err != nil {
+		return "", nil, err
+	}
+
+	operation := values.Get("Action")
+	if operation == "" {
+		return "", nil, errors.New("missing Action parameter")
+	}
+
+	values.Del("Action")
+	values.Del("Version")
+
+	return operation, vcr.DecodeForm(values), nil
+}
+
+func (p *queryProtocol) encode(w http.ResponseWriter, operation string, output map[string]any) {
+	var sb strings.Builder
+
+	sb.WriteString(xml.Header)
+	fmt.Fprintf(&sb, "<%[1]sResponse><%[1]sResult>", operation)
+	writeXML(&sb, output)
+	fmt.Fprintf(&sb, "</%[1]sResult><ResponseMetadata><RequestId>%[2]s</RequestId></ResponseMetadata></%[1]sResponse>", operation, requestID())
+
+	w.Header().Set("Content-Type", "text/xml")
+	w.WriteHeader(http.StatusOK)
+	io.WriteString(w, sb.String())
+}
+
+func (p *queryProtocol) encodeError(w http.ResponseWriter, err error) {
+	e := asError(err)
+
+	var sb strings.Builder
+	sb.WriteString(xml.Header)
+	sb.WriteString("<ErrorResponse><Error><Type>Sender</Type><Code>")
+	xml.EscapeText(&sb, []byte(e.Code))
+	sb.WriteString("</Code><Message>")
+	xml.EscapeText(&sb, []byte(e.Message))
+	fmt.Fprintf(&sb, "</Message></Error><RequestId>%s</RequestId></ErrorResponse>", requestID())
+
+	w.Header().Set("Content-Type", "text/xml")
+	w.WriteHeader(e.StatusCode)
+	io.WriteString(w, sb.String())
+}
+
+func writeXML(sb *strings.Builder, v any) {
+	switch v := v.(type) {
+	case map[string]any:
+		for _, k := range slices.Sorted(maps.Keys(v)) {
+			fmt.Fprintf(sb, "<%s>", k)
+			writeXML(sb, v[k])
+			fmt.Fprintf(sb, "</%s>", k)
+		}
+	case []any:
+		for _, v := range v {
+			sb.WriteString("<member>")
+			writeXML(sb, v)
+			sb.WriteString("</member>")
+		}
+	case time.Time:
+		sb.WriteString(v.UTC().Format(time.RFC3339))
+	case nil:
+	default:
+		xml.EscapeText(sb, fmt.Append(nil, v))
+	}
+}
+
+func asError(err error) *Error {
+	if e := (*Error)(nil); errors.As(err, &e) {
+		return e
+	}
+
+	// Use a non-retryable status code so that handler failures surface immediately.
+	return &Error{
+		Code:       "InternalFailure",
+		Message:    err.Error(),
+		StatusCode: http.StatusBadRequest,
+	}
+}
+
+func requestID() string {
+	v, _ := uuid.GenerateUUID()
+	return v
+}
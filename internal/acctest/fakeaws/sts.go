@@ -0,0 +1,25 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// STS is always served so that the provider can determine the caller's account.
+func init() {
+	RegisterService(names.STS, func() Operations {
+		return Operations{
+			"GetCallerIdentity": func(context.Context, map[string]any) (map[string]any, error) {
+				return map[string]any{
+					"Account": AccountID,
+					"Arn":     GlobalARN("iam", "user/fakeaws"),
+					"UserId":  "AIDAFAKEAWS",
+				}, nil
+			},
+		}
+	})
+}
@@ -7,18 +7,18 @@ import (
 	"bytes"
 	"context"
 	"crypto/tls"
-	"encoding/xml"
 	"fmt"
 	"io"
 	"math/rand"
 	"net/http"
 	"os"
 	"path/filepath"
-	"reflect"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
 
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
 	cleanhttp "github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -26,9 +26,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fakeaws"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
-	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
 	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
 	"gopkg.in/dnaeon/go-vcr.v4/pkg/cassette"
@@ -153,6 +153,13 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 			return nil
 		}
 
+		// Before save hook to redact sensitive values from HTTP bodies.
+		// Redaction is deferred until the cassette is saved so that the test itself sees the real values.
+		realTransport := &invocationTransport{RoundTripper: httpClient.Transport}
+		sensitiveBodyHook := func(i *cassette.Interaction) error {
+			return vcr.RedactInteraction(realTransport.serviceID(i), i)
+		}
+
 		// Define how VCR will match requests to stored interactions.
 		matchFunc := func(r *http.Request, i cassette.Request) bool {
 			if r.Method != i.Method {
@@ -182,34 +189,7 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 				return true
 			}
 
-			// https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
-			switch contentType := r.Header.Get("Content-Type"); contentType {
-			case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
-				// JSON might be the same, but reordered. Try parsing and comparing.
-				return tfjson.EqualStrings(body, i.Body)
-
-			case "application/xml":
-				// XML might be the same, but reordered. Try parsing and comparing.
-				var requestXml, cassetteXml any
-
-				if err := xml.Unmarshal([]byte(body), &requestXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request XML", map[string]any{
-						"error": err,
-					})
-					return false
-				}
-
-				if err := xml.Unmarshal([]byte(i.Body), &cassetteXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette XML", map[string]any{
-						"error": err,
-					})
-					return false
-				}
-
-				return reflect.DeepEqual(requestXml, cassetteXml)
-			}
-
-			return false
+			return vcr.RequestBodiesMatch(ctx, r, body, i.Body)
 		}
 
 		cassetteName := filepath.Join(vcr.Path(), vcrFileName(testName))
@@ -217,9 +197,10 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 		// Create a VCR recorder around a default HTTP client.
 		r, err := recorder.New(cassetteName,
 			recorder.WithHook(sensitiveHeaderHook, recorder.AfterCaptureHook),
+			recorder.WithHook(sensitiveBodyHook, recorder.BeforeSaveHook),
 			recorder.WithMatcher(matchFunc),
 			recorder.WithMode(vcrMode),
-			recorder.WithRealTransport(httpClient.Transport),
+			recorder.WithRealTransport(realTransport),
 			recorder.WithSkipRequestLatency(true),
 		)
 
@@ -251,6 +232,33 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 	}
 }
 
+// invocationTransport is an http.RoundTripper that records the AWS service ID of each AWS SDK operation invocation.
+type invocationTransport struct {
+	http.RoundTripper
+	serviceIDs sync.Map
+}
+
+const (
+	invocationIDHeader = "Amz-Sdk-Invocation-Id"
+)
+
+func (t *invocationTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	if id := r.Header.Get(invocationIDHeader); id != "" {
+		t.serviceIDs.Store(id, awsmiddleware.GetServiceID(r.Context()))
+	}
+
+	return t.RoundTripper.RoundTrip(r)
+}
+
+// serviceID returns the AWS service ID of the operation invocation that made the recorded request.
+func (t *invocationTransport) serviceID(i *cassette.Interaction) string {
+	if v, ok := t.serviceIDs.Load(i.Request.Headers.Get(invocationIDHeader)); ok {
+		return v.(string)
+	}
+
+	return ""
+}
+
 // vcrRandomnessSource returns a rand.Source for VCR testing
 //
 // In RECORD_ONLY mode, generates a new seed and saves it to a file, using the
@@ -392,11 +400,17 @@ func closeVCRRecorder(ctx context.Context, t *testing.T) {
 	}
 }
 
-// ParallelTest wraps resource.ParallelTest, initializing VCR if enabled
+// ParallelTest wraps resource.ParallelTest, initializing fake AWS or VCR if enabled
 func ParallelTest(ctx context.Context, t *testing.T, c resource.TestCase) {
 	t.Helper()
 
-	if vcr.IsEnabled() {
+	if fakeaws.IsEnabled() {
+		if c.ProtoV5ProviderFactories != nil {
+			c.ProtoV5ProviderFactories = fakeAWSEnabledProtoV5ProviderFactories(ctx, t, c.ProtoV5ProviderFactories)
+		} else {
+			t.Skip("fake AWS is not currently supported for test step ProtoV5ProviderFactories")
+		}
+	} else if vcr.IsEnabled() {
 		if c.ProtoV5ProviderFactories != nil {
 			c.ProtoV5ProviderFactories = vcrEnabledProtoV5ProviderFactories(ctx, t, c.ProtoV5ProviderFactories)
 			defer closeVCRRecorder(ctx, t)
@@ -408,11 +422,17 @@ func ParallelTest(ctx context.Context, t *testing.T, c resource.TestCase) {
 	resource.ParallelTest(t, c)
 }
 
-// Test wraps resource.Test, initializing VCR if enabled
+// Test wraps resource.Test, initializing fake AWS or VCR if enabled
 func Test(ctx context.Context, t *testing.T, c resource.TestCase) {
 	t.Helper()
 
-	if vcr.IsEnabled() {
+	if fakeaws.IsEnabled() {
+		if c.ProtoV5ProviderFactories != nil {
+			c.ProtoV5ProviderFactories = fakeAWSEnabledProtoV5ProviderFactories(ctx, t, c.ProtoV5ProviderFactories)
+		} else {
+			t.Skip("fake AWS is not currently supported for test step ProtoV5ProviderFactories")
+		}
+	} else if vcr.IsEnabled() {
 		if c.ProtoV5ProviderFactories != nil {
 			c.ProtoV5ProviderFactories = vcrEnabledProtoV5ProviderFactories(ctx, t, c.ProtoV5ProviderFactories)
 			defer closeVCRRecorder(ctx, t)
@@ -22,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/dns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -29,7 +30,8 @@ import (
 type AWSClient struct {
 	accountID                 string
 	awsConfig                 *aws.Config
-	clients                   map[string]map[string]any // Region -> service package name -> API client.
+	clients                   map[string]map[string]any   // Region -> service package name -> API client.
+	concurrencyLimits         map[string]tfsync.Semaphore // Service package name -> in-flight mutations semaphore.
 	defaultTagsConfig         *tftags.DefaultConfig
 	endpoints                 map[string]string // From provider configuration.
 	httpClient                *http.Client
@@ -39,9 +41,10 @@ type AWSClient struct {
 	partition                 endpoints.Partition
 	servicePackages           map[string]ServicePackage
 	s3ExpressClient           *s3.Client
-	s3UsePathStyle            bool   // From provider configuration.
-	s3USEast1RegionalEndpoint string // From provider configuration.
-	stsRegion                 string // From provider configuration.
+	serviceAWSConfigs         map[string]*aws.Config // Service package name -> AWS SDK configuration with overrides.
+	s3UsePathStyle            bool                   // From provider configuration.
+	s3USEast1RegionalEndpoint string                 // From provider configuration.
+	stsRegion                 string                 // From provider configuration.
 	tagPolicyConfig           *tftags.TagPolicyConfig
 	terraformVersion          string // From provider configuration.
 }
@@ -86,6 +89,13 @@ func (c *AWSClient) TagPolicyConfig(context.Context) *tftags.TagPolicyConfig {
 	return c.tagPolicyConfig
 }
 
+// ConcurrencyLimit returns the semaphore limiting in-flight resource mutations for the specified service package.
+// ok is false if no limit is configured.
+func (c *AWSClient) ConcurrencyLimit(_ context.Context, servicePackageName string) (tfsync.Semaphore, bool) {
+	semaphore, ok := c.concurrencyLimits[servicePackageName]
+	return semaphore, ok
+}
+
 func (c *AWSClient) AwsConfig(context.Context) aws.Config { // nosemgrep:ci.aws-in-func-name
 	return c.awsConfig.Copy()
 }
@@ -337,8 +347,13 @@ func convertIPToDashIP(ip string) string {
 
 // apiClientConfig returns the AWS API client configuration parameters for the specified service.
 func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName string) map[string]any {
+	awsConfig := c.awsConfig
+	if v, ok := c.serviceAWSConfigs[servicePackageName]; ok {
+		awsConfig = v
+	}
+
 	m := map[string]any{
-		"aws_sdkv2_config": c.awsConfig,
+		"aws_sdkv2_config": awsConfig,
 		"endpoint":         c.endpoints[servicePackageName],
 		"partition":        c.Partition(ctx),
 		"region":           c.Region(ctx),
@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tags/tagpolicy"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -31,6 +32,7 @@ type Config struct {
 	AllowedAccountIds              []string
 	AssumeRole                     []awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
+	ConcurrencyLimits              map[string]int // Service package name -> maximum in-flight mutations.
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
 	EC2MetadataServiceEnableState  imds.ClientEnableState
@@ -50,6 +52,7 @@ type Config struct {
 	S3UsePathStyle                 bool
 	S3USEast1RegionalEndpoint      string
 	SecretKey                      string
+	ServiceOverrides               map[string]ServiceOverride // Service package name -> overrides.
 	SharedConfigFiles              []string
 	SharedCredentialsFiles         []string
 	SkipCredsValidation            bool
@@ -58,6 +61,8 @@ type Config struct {
 	STSRegion                      string
 	SuppressDebugLog               bool
 	TagPolicyConfig                *tftags.TagPolicyConfig
+	TagPolicyFile                  string
+	TagPolicyFileMerge             bool
 	TerraformVersion               string
 	Token                          string
 	TokenBucketRateLimiterCapacity int
@@ -66,15 +71,16 @@ type Config struct {
 	UserAgent                      awsbase.UserAgentProducts
 }
 
+const (
+	maxBackoff = 300 * time.Second // AWS SDK for Go v1 DefaultRetryerMaxRetryDelay: https://github.com/aws/aws-sdk-go/blob/9f6e3bb9f523aef97fa1cd5c5f8ba8ecf212e44e/aws/client/default_retryer.go#L48-L49.
+)
+
 // ConfigureProvider configures the provided provider Meta (instance data).
 func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWSClient, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	ctx, logger := logging.NewTfLogger(ctx)
 
-	const (
-		maxBackoff = 300 * time.Second // AWS SDK for Go v1 DefaultRetryerMaxRetryDelay: https://github.com/aws/aws-sdk-go/blob/9f6e3bb9f523aef97fa1cd5c5f8ba8ecf212e44e/aws/client/default_retryer.go#L48-L49.
-	)
 	awsbaseConfig := awsbase.Config{
 		AccessKey:         c.AccessKey,
 		AllowedAccountIds: c.AllowedAccountIds,
@@ -197,20 +203,54 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 
 	// Fetch tag policy details when enforced
 	if c.TagPolicyConfig != nil {
-		tflog.Debug(ctx, "Retrieving tag policy details")
-		reqTags, err := tagpolicy.GetRequiredTags(ctx, cfg)
-		if err != nil {
-			diags = append(diags, errs.NewErrorDiagnostic(
-				"Retrieving Required Tags",
-				`Failed to retrieve required tags from the organizations tag policies. Ensure the calling principal `+
-					`has the "tag:ListRequiredTags" IAM permission and that tag policies are attached to the target account.`+
-					fmt.Sprintf("\n\nOriginal error: %s", err)))
-			return nil, diags
+		if c.TagPolicyFile != "" {
+			tflog.Debug(ctx, "Reading tag policy file", map[string]any{
+				"path": c.TagPolicyFile,
+			})
+			reqTags, rules, err := tagpolicy.ReadPolicyFile(ctx, c.TagPolicyFile)
+			if err != nil {
+				diags = append(diags, errs.NewErrorDiagnostic(
+					"Reading Tag Policy File",
+					fmt.Sprintf("Failed to read tag policy from %q. Ensure the file exists and contains a tag policy in JSON format.", c.TagPolicyFile)+
+						fmt.Sprintf("\n\nOriginal error: %s", err)))
+				return nil, diags
+			}
+			c.TagPolicyConfig.RequiredTags = reqTags
+			c.TagPolicyConfig.Rules = rules
+		}
+
+		// The local tag policy is used in place of the organizations tag policies unless merging is requested
+		if c.TagPolicyFile == "" || c.TagPolicyFileMerge {
+			tflog.Debug(ctx, "Retrieving tag policy details")
+			reqTags, err := tagpolicy.GetRequiredTags(ctx, cfg)
+			if err != nil {
+				diags = append(diags, errs.NewErrorDiagnostic(
+					"Retrieving Required Tags",
+					`Failed to retrieve required tags from the organizations tag policies. Ensure the calling principal `+
+						`has the "tag:ListRequiredTags" IAM permission and that tag policies are attached to the target account.`+
+						fmt.Sprintf("\n\nOriginal error: %s", err)))
+				return nil, diags
+			}
+			c.TagPolicyConfig.RequiredTags = tagpolicy.MergeRequiredTags(reqTags, c.TagPolicyConfig.RequiredTags)
+
+			rules, err := tagpolicy.GetEffectiveTagPolicy(ctx, cfg)
+			if err != nil {
+				// Tag key and value rules are only validated when the effective tag policy is readable
+				diags = append(diags, errs.NewWarningDiagnostic(
+					"Retrieving Effective Tag Policy",
+					`Failed to retrieve the effective tag policy. Tag keys and values will not be validated against the policy. `+
+						`Ensure the calling principal has the "organizations:DescribeEffectivePolicy" IAM permission.`+
+						fmt.Sprintf("\n\nOriginal error: %s", err)))
+			}
+			c.TagPolicyConfig.Rules = tagpolicy.MergeRules(rules, c.TagPolicyConfig.Rules)
 		}
-		c.TagPolicyConfig.RequiredTags = reqTags
 	}
 
 	client.accountID = accountID
+	client.concurrencyLimits = make(map[string]tfsync.Semaphore, len(c.ConcurrencyLimits))
+	for servicePackageName, limit := range c.ConcurrencyLimits {
+		client.concurrencyLimits[servicePackageName] = tfsync.NewSemaphore(limit)
+	}
 	client.defaultTagsConfig = c.DefaultTagsConfig
 	client.ignoreTagsConfig = c.IgnoreTagsConfig
 	client.tagPolicyConfig = c.TagPolicyConfig
@@ -218,6 +258,14 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 
 	// Used for lazy-loading AWS API clients.
 	client.awsConfig = &cfg
+	client.serviceAWSConfigs = make(map[string]*aws.Config, len(c.ServiceOverrides))
+	for servicePackageName, override := range c.ServiceOverrides {
+		tflog.Debug(ctx, "Applying service overrides", map[string]any{
+			"tf_aws.service_package": servicePackageName,
+		})
+		serviceCfg := override.apply(cfg.Copy())
+		client.serviceAWSConfigs[servicePackageName] = &serviceCfg
+	}
 	client.clients = make(map[string]map[string]any, 0)
 	client.endpoints = c.Endpoints
 	client.logger = logger
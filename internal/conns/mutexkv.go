@@ -4,46 +4,205 @@
 package conns
 
 import (
+	"context"
+	"fmt"
 	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // GlobalMutexKV is a global MutexKV for use within this plugin.
 var GlobalMutexKV = newMutexKV()
 
-// mutexKV is a simple key/value store for arbitrary mutexes. It can be used to
+// mutexKV is a key/value store of shared/exclusive locks. It can be used to
 // serialize changes across arbitrary collaborators that share knowledge of the
 // keys they must serialize on.
+// Locks are reference counted and a key's entry is removed once it is neither
+// held nor waited on, so the store does not grow without bound.
 type mutexKV struct {
 	lock  sync.Mutex
-	store map[string]*sync.Mutex
+	store map[string]*keyedLock
+}
+
+// keyedLock is the state of the lock for a single key.
+// All fields are protected by the owning mutexKV's lock.
+type keyedLock struct {
+	// refs is the number of holders plus waiters.
+	refs int
+	// readers is the number of shared holders.
+	readers int
+	// writer is whether the lock is held exclusively.
+	writer bool
+	// writersWaiting is the number of exclusive waiters. Shared lockers wait while it is non-zero so that writers aren't starved.
+	writersWaiting int
+	// released is closed, and replaced, whenever the lock is released.
+	released chan struct{}
 }
 
 // Locks the mutex for the given key. Caller is responsible for calling Unlock
 // for the same key
 func (m *mutexKV) Lock(key string) {
-	m.get(key).Lock()
+	_ = m.acquire(context.Background(), key, false)
+}
+
+// LockContext locks the mutex for the given key, waiting until the lock is
+// acquired or the context is done. If an error is returned the lock is not held.
+// Otherwise the caller is responsible for calling Unlock for the same key.
+func (m *mutexKV) LockContext(ctx context.Context, key string) error {
+	return m.lockContext(ctx, key, false)
 }
 
 // Unlock the mutex for the given key. Caller must have called Lock for the same key first
 func (m *mutexKV) Unlock(key string) {
-	m.get(key).Unlock()
+	m.release(key, false)
+}
+
+// RLock locks the mutex for the given key for shared access. Caller is
+// responsible for calling RUnlock for the same key.
+func (m *mutexKV) RLock(key string) {
+	_ = m.acquire(context.Background(), key, true)
+}
+
+// RLockContext locks the mutex for the given key for shared access, waiting
+// until the lock is acquired or the context is done. If an error is returned
+// the lock is not held. Otherwise the caller is responsible for calling RUnlock
+// for the same key.
+func (m *mutexKV) RLockContext(ctx context.Context, key string) error {
+	return m.lockContext(ctx, key, true)
+}
+
+// RUnlock unlocks shared access to the mutex for the given key. Caller must
+// have called RLock for the same key first
+func (m *mutexKV) RUnlock(key string) {
+	m.release(key, true)
+}
+
+func (m *mutexKV) lockContext(ctx context.Context, key string, shared bool) error {
+	mode := "exclusive"
+	if shared {
+		mode = "shared"
+	}
+	ctx = tflog.SetField(ctx, "lock_key", key)
+	ctx = tflog.SetField(ctx, "lock_mode", mode)
+
+	start := time.Now()
+	err := m.acquire(ctx, key, shared)
+	wait := time.Since(start)
+
+	if err != nil {
+		tflog.Debug(ctx, "Lock not acquired", map[string]any{
+			"lock_wait_duration": wait.String(),
+			"error":              err.Error(),
+		})
+
+		return fmt.Errorf("waiting for %s lock (%s): %w", mode, key, err)
+	}
+
+	tflog.Debug(ctx, "Lock acquired", map[string]any{
+		"lock_wait_duration": wait.String(),
+	})
+
+	return nil
 }
 
-// Returns a mutex for the given key, no guarantee of its lock status
-func (m *mutexKV) get(key string) *sync.Mutex {
+func (m *mutexKV) acquire(ctx context.Context, key string, shared bool) error {
 	m.lock.Lock()
-	defer m.lock.Unlock()
-	mutex, ok := m.store[key]
+
+	l, ok := m.store[key]
 	if !ok {
-		mutex = &sync.Mutex{}
-		m.store[key] = mutex
+		l = &keyedLock{
+			released: make(chan struct{}),
+		}
+		m.store[key] = l
+	}
+	l.refs++
+
+	if !shared {
+		l.writersWaiting++
 	}
-	return mutex
+
+	for {
+		if shared && !l.writer && l.writersWaiting == 0 {
+			l.readers++
+			m.lock.Unlock()
+
+			return nil
+		}
+
+		if !shared && !l.writer && l.readers == 0 {
+			l.writersWaiting--
+			l.writer = true
+			m.lock.Unlock()
+
+			return nil
+		}
+
+		released := l.released
+		m.lock.Unlock()
+
+		select {
+		case <-released:
+		case <-ctx.Done():
+			m.lock.Lock()
+			if !shared {
+				l.writersWaiting--
+			}
+			// Waiting shared lockers may now be able to proceed.
+			m.notify(key, l)
+			m.lock.Unlock()
+
+			return ctx.Err()
+		}
+
+		m.lock.Lock()
+	}
+}
+
+func (m *mutexKV) release(key string, shared bool) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	l, ok := m.store[key]
+
+	switch {
+	case shared && (!ok || l.readers == 0):
+		panic(fmt.Sprintf("RUnlock of unlocked mutex (%s)", key))
+	case !shared && (!ok || !l.writer):
+		panic(fmt.Sprintf("Unlock of unlocked mutex (%s)", key))
+	case shared:
+		l.readers--
+	default:
+		l.writer = false
+	}
+
+	m.notify(key, l)
+}
+
+// notify drops a reference to the lock for the given key and wakes any waiters.
+// The mutexKV's lock must be held.
+func (m *mutexKV) notify(key string, l *keyedLock) {
+	l.refs--
+
+	if l.refs == 0 {
+		delete(m.store, key)
+	}
+
+	close(l.released)
+	l.released = make(chan struct{})
+}
+
+// size returns the number of keys with a lock held or waited on.
+func (m *mutexKV) size() int {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	return len(m.store)
 }
 
 // Returns a properly initialized MutexKV
 func newMutexKV() *mutexKV {
 	return &mutexKV{
-		store: make(map[string]*sync.Mutex),
+		store: make(map[string]*keyedLock),
 	}
 }
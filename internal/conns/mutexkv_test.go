@@ -4,6 +4,8 @@
 package conns
 
 import (
+	"context"
+	"errors"
 	"testing"
 	"time"
 )
@@ -74,3 +76,120 @@ func TestMutexKVDifferentKeys(t *testing.T) {
 		t.Fatal("Second lock on a different key blocked. This shouldn't happen.")
 	}
 }
+
+func TestMutexKVEviction(t *testing.T) {
+	t.Parallel()
+
+	mkv := newMutexKV()
+
+	mkv.Lock("foo")
+	mkv.RLock("bar")
+	mkv.RLock("bar")
+
+	if got, want := mkv.size(), 2; got != want {
+		t.Fatalf("size = %d, want %d", got, want)
+	}
+
+	mkv.Unlock("foo")
+	mkv.RUnlock("bar")
+
+	if got, want := mkv.size(), 1; got != want {
+		t.Fatalf("size = %d, want %d", got, want)
+	}
+
+	mkv.RUnlock("bar")
+
+	if got, want := mkv.size(), 0; got != want {
+		t.Fatalf("size = %d, want %d", got, want)
+	}
+}
+
+func TestMutexKVLockContext(t *testing.T) {
+	t.Parallel()
+
+	mkv := newMutexKV()
+
+	if err := mkv.LockContext(context.Background(), "foo"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err := mkv.LockContext(ctx, "foo")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("error = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	mkv.Unlock("foo")
+
+	if got, want := mkv.size(), 0; got != want {
+		t.Fatalf("size = %d, want %d", got, want)
+	}
+
+	if err := mkv.LockContext(context.Background(), "foo"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestMutexKVShared(t *testing.T) {
+	t.Parallel()
+
+	mkv := newMutexKV()
+
+	mkv.RLock("foo")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	// Multiple shared locks can be held.
+	if err := mkv.RLockContext(ctx, "foo"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	doneCh := make(chan struct{})
+
+	go func() {
+		mkv.Lock("foo")
+		close(doneCh)
+	}()
+
+	select {
+	case <-doneCh:
+		t.Fatal("Exclusive lock was able to be taken while shared locks held. This shouldn't happen.")
+	case <-time.After(50 * time.Millisecond):
+		// pass
+	}
+
+	// Shared lockers wait behind a waiting exclusive locker.
+	ctx, cancel = context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if err := mkv.RLockContext(ctx, "foo"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("error = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	mkv.RUnlock("foo")
+	mkv.RUnlock("foo")
+
+	select {
+	case <-doneCh:
+		// pass
+	case <-time.After(50 * time.Millisecond):
+		t.Fatal("Exclusive lock blocked after shared unlocks. This shouldn't happen.")
+	}
+}
+
+func TestMutexKVUnlockOfUnlocked(t *testing.T) {
+	t.Parallel()
+
+	mkv := newMutexKV()
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatal("expected panic")
+		}
+	}()
+
+	mkv.Unlock("foo")
+}
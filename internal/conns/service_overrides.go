@@ -0,0 +1,147 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/ratelimit"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+)
+
+// ServiceOverride holds settings for a single service's API clients that override the provider-level settings.
+// Zero values mean the provider-level setting is used.
+type ServiceOverride struct {
+	MaxRetries                     int
+	RetryMode                      aws.RetryMode
+	TokenBucketRateLimiterCapacity int
+}
+
+// apply returns the AWS SDK for Go v2 configuration with the overrides applied.
+// cfg must be a copy of the provider-level configuration.
+func (o ServiceOverride) apply(cfg aws.Config) aws.Config {
+	if o.MaxRetries != 0 {
+		// Service API clients wrap their retryer with this maximum.
+		cfg.RetryMaxAttempts = o.MaxRetries
+	}
+
+	providerRetryMode := cmp.Or(cfg.RetryMode, aws.RetryModeStandard)
+	retryMode := cmp.Or(o.RetryMode, providerRetryMode)
+	cfg.RetryMode = retryMode
+
+	if retryMode == providerRetryMode && o.TokenBucketRateLimiterCapacity == 0 {
+		return cfg
+	}
+
+	// The retryer constructed by aws-sdk-go-base is wrapped, keeping its backoff, maximum attempts and networking error handling.
+	newProviderRetryer := cfg.Retryer
+	tokenBucketRateLimiterCapacity := o.TokenBucketRateLimiterCapacity
+
+	cfg.Retryer = func() aws.Retryer {
+		retryer := asRetryerV2(newProviderRetryer())
+
+		if tokenBucketRateLimiterCapacity > 0 {
+			retryer = &rateLimitedRetryer{
+				RetryerV2:   retryer,
+				rateLimiter: ratelimit.NewTokenRateLimit(uint(tokenBucketRateLimiterCapacity)),
+			}
+		}
+
+		if retryMode != providerRetryMode {
+			retryer = &retryModeRetryer{
+				RetryerV2: retryer,
+				adaptive:  retryMode == aws.RetryModeAdaptive,
+				mode:      retry.NewAdaptiveMode(),
+			}
+		}
+
+		return retryer
+	}
+
+	return cfg
+}
+
+func asRetryerV2(r aws.Retryer) aws.RetryerV2 {
+	if v, ok := r.(aws.RetryerV2); ok {
+		return v
+	}
+
+	// AddWithMaxAttempts wraps its retryer as an aws.RetryerV2.
+	return retry.AddWithMaxAttempts(r, r.MaxAttempts()).(aws.RetryerV2)
+}
+
+// rateLimitedRetryer takes retry tokens from its own token bucket instead of the wrapped retryer's.
+// Token costs are the AWS SDK for Go v2 defaults.
+type rateLimitedRetryer struct {
+	aws.RetryerV2
+	rateLimiter *ratelimit.TokenRateLimit
+}
+
+func (r *rateLimitedRetryer) GetAttemptToken(ctx context.Context) (func(error) error, error) {
+	release, err := r.RetryerV2.GetAttemptToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(err error) error {
+		if err != nil {
+			return release(err)
+		}
+
+		return errors.Join(release(nil), r.rateLimiter.AddTokens(retry.DefaultNoRetryIncrement))
+	}, nil
+}
+
+func (r *rateLimitedRetryer) GetRetryToken(ctx context.Context, opErr error) (func(error) error, error) {
+	cost := retry.DefaultRetryCost
+	if retry.IsErrorTimeouts(retry.DefaultTimeouts).IsErrorTimeout(opErr).Bool() {
+		cost = retry.DefaultRetryTimeoutCost
+	}
+
+	release, err := r.rateLimiter.GetToken(ctx, cost)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rate limit token, %w", err)
+	}
+
+	return func(err error) error {
+		if err != nil {
+			return nil
+		}
+
+		return release()
+	}, nil
+}
+
+// retryModeRetryer changes the retry mode of the wrapped retryer.
+// In adaptive mode, attempts are additionally rate limited by the AWS SDK for Go v2's adaptive retryer.
+// In standard mode, the wrapped adaptive retryer's client-side rate limiting is bypassed.
+type retryModeRetryer struct {
+	aws.RetryerV2
+	adaptive bool
+	mode     *retry.AdaptiveMode
+}
+
+func (r *retryModeRetryer) GetAttemptToken(ctx context.Context) (func(error) error, error) {
+	if !r.adaptive {
+		return r.GetInitialToken(), nil //nolint:staticcheck // Bypasses the wrapped retryer's attempt rate limiting.
+	}
+
+	release, err := r.mode.GetAttemptToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	releaseWrapped, err := r.RetryerV2.GetAttemptToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(err error) error {
+		return errors.Join(release(err), releaseWrapped(err))
+	}, nil
+}
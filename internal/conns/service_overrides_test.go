@@ -0,0 +1,161 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/ratelimit"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+)
+
+func TestServiceOverrideApply(t *testing.T) {
+	t.Parallel()
+
+	providerRetryer := func() aws.Retryer {
+		return retry.NewStandard(func(so *retry.StandardOptions) {
+			so.MaxAttempts = 7
+		})
+	}
+
+	testCases := map[string]struct {
+		override            ServiceOverride
+		providerRetryMode   aws.RetryMode
+		expectedMaxAttempts int
+		expectedRetryMode   aws.RetryMode
+		expectProviderRetry bool
+		expectRateLimited   bool
+		expectAdaptive      *bool
+	}{
+		"none": {
+			expectedMaxAttempts: 25,
+			expectedRetryMode:   aws.RetryModeStandard,
+			expectProviderRetry: true,
+		},
+		"max retries": {
+			override:            ServiceOverride{MaxRetries: 50},
+			expectedMaxAttempts: 50,
+			expectedRetryMode:   aws.RetryModeStandard,
+			expectProviderRetry: true,
+		},
+		"retry mode unchanged": {
+			override:            ServiceOverride{RetryMode: aws.RetryModeStandard},
+			expectedMaxAttempts: 25,
+			expectedRetryMode:   aws.RetryModeStandard,
+			expectProviderRetry: true,
+		},
+		"retry mode adaptive": {
+			override:            ServiceOverride{MaxRetries: 100, RetryMode: aws.RetryModeAdaptive},
+			expectedMaxAttempts: 100,
+			expectedRetryMode:   aws.RetryModeAdaptive,
+			expectAdaptive:      aws.Bool(true),
+		},
+		"retry mode standard": {
+			override:            ServiceOverride{RetryMode: aws.RetryModeStandard},
+			providerRetryMode:   aws.RetryModeAdaptive,
+			expectedMaxAttempts: 25,
+			expectedRetryMode:   aws.RetryModeStandard,
+			expectAdaptive:      aws.Bool(false),
+		},
+		"token bucket": {
+			override:            ServiceOverride{TokenBucketRateLimiterCapacity: 1000},
+			expectedMaxAttempts: 25,
+			expectedRetryMode:   aws.RetryModeStandard,
+			expectRateLimited:   true,
+		},
+		"retry mode and token bucket": {
+			override:            ServiceOverride{RetryMode: aws.RetryModeAdaptive, TokenBucketRateLimiterCapacity: 1000},
+			expectedMaxAttempts: 25,
+			expectedRetryMode:   aws.RetryModeAdaptive,
+			expectRateLimited:   true,
+			expectAdaptive:      aws.Bool(true),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := aws.Config{
+				RetryMaxAttempts: 25,
+				RetryMode:        testCase.providerRetryMode,
+				Retryer:          providerRetryer,
+			}
+
+			got := testCase.override.apply(cfg.Copy())
+
+			if got, want := got.RetryMaxAttempts, testCase.expectedMaxAttempts; got != want {
+				t.Errorf("RetryMaxAttempts = %d, want %d", got, want)
+			}
+			if got, want := got.RetryMode, testCase.expectedRetryMode; got != want {
+				t.Errorf("RetryMode = %q, want %q", got, want)
+			}
+
+			retryer := got.Retryer()
+			if got, want := retryer.MaxAttempts(), 7; got != want {
+				t.Errorf("Retryer MaxAttempts = %d, want provider retryer's %d", got, want)
+			}
+			if testCase.expectProviderRetry {
+				if _, ok := retryer.(*retry.Standard); !ok {
+					t.Errorf("Retryer = %T, want provider retryer", retryer)
+				}
+				return
+			}
+
+			if v, ok := retryer.(*retryModeRetryer); ok {
+				if testCase.expectAdaptive == nil || v.adaptive != *testCase.expectAdaptive {
+					t.Errorf("Retryer adaptive = %t, want %v", v.adaptive, testCase.expectAdaptive)
+				}
+				retryer = v.RetryerV2
+			} else if testCase.expectAdaptive != nil {
+				t.Errorf("Retryer = %T, want retry mode retryer", retryer)
+			}
+
+			if v, ok := retryer.(*rateLimitedRetryer); ok != testCase.expectRateLimited {
+				t.Errorf("Retryer = %T, rate limited want %t", retryer, testCase.expectRateLimited)
+			} else if ok {
+				retryer = v.RetryerV2
+			}
+
+			if _, ok := retryer.(*retry.Standard); !ok {
+				t.Errorf("wrapped Retryer = %T, want provider retryer", retryer)
+			}
+			if cfg.RetryMode != testCase.providerRetryMode || cfg.RetryMaxAttempts != 25 {
+				t.Error("provider-level configuration modified")
+			}
+		})
+	}
+}
+
+func TestRateLimitedRetryer(t *testing.T) {
+	t.Parallel()
+	ctx := t.Context()
+
+	retryer := &rateLimitedRetryer{
+		RetryerV2: retry.NewStandard(func(so *retry.StandardOptions) {
+			so.RateLimiter = ratelimit.None
+		}),
+		rateLimiter: ratelimit.NewTokenRateLimit(retry.DefaultRetryCost),
+	}
+	opErr := errors.New("test")
+
+	release, err := retryer.GetRetryToken(ctx, opErr)
+	if err != nil {
+		t.Fatalf("GetRetryToken: %s", err)
+	}
+
+	if _, err := retryer.GetRetryToken(ctx, opErr); err == nil {
+		t.Error("GetRetryToken: expected error from exhausted token bucket")
+	}
+
+	if err := release(nil); err != nil {
+		t.Fatalf("release: %s", err)
+	}
+
+	if _, err := retryer.GetRetryToken(ctx, opErr); err != nil {
+		t.Errorf("GetRetryToken after release: %s", err)
+	}
+}
@@ -4,6 +4,7 @@
 package sync
 
 import (
+	"context"
 	"os"
 	"strconv"
 	"sync"
@@ -45,14 +46,28 @@ func GetSemaphore(key, envvar string, defaultLimit int) Semaphore {
 	return semaphore
 }
 
+// NewSemaphore returns an unnamed semaphore with the specified capacity.
+func NewSemaphore(limit int) Semaphore {
+	return make(Semaphore, limit)
+}
+
 // Wait waits for a semaphore before continuing
-// NOTE: this is currently an experimental feature and is likely to change. DO NOT USE.
 func (s Semaphore) Wait() {
 	s <- struct{}{}
 }
 
+// WaitContext waits for a semaphore before continuing, or until the context is done.
+// If an error is returned the semaphore has not been acquired and Notify must not be called.
+func (s Semaphore) WaitContext(ctx context.Context) error {
+	select {
+	case s <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 // Notify releases a semaphore
-// NOTE: this is currently an experimental feature and is likely to change. DO NOT USE.
 func (s Semaphore) Notify() {
 	// Make the Notify non-blocking. This can happen if a Wait was never issued
 	select {
@@ -10,6 +10,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
@@ -105,6 +106,53 @@ func TestCIDRBlockValidateAttrbute(t *testing.T) {
 	}
 }
 
+func TestCIDRBlockValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         fwtypes.CIDRBlock
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"unknown": {
+			val: fwtypes.CIDRBlockUnknown(),
+		},
+		"null": {
+			val: fwtypes.CIDRBlockNull(),
+		},
+		"valid IPv4": {
+			val: fwtypes.CIDRBlockValue("10.2.2.0/24"),
+		},
+		"invalid IPv4": {
+			val:         fwtypes.CIDRBlockValue("10.2.2.2/24"),
+			expectError: true,
+		},
+		"valid IPv6": {
+			val: fwtypes.CIDRBlockValue("2000::/15"),
+		},
+		"invalid IPv6": {
+			val:         fwtypes.CIDRBlockValue("2001::/15"),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			req := function.ValidateParameterRequest{}
+			resp := function.ValidateParameterResponse{}
+
+			test.val.ValidateParameter(ctx, req, &resp)
+			if got := resp.Error != nil; got != test.expectError {
+				t.Errorf("resp.Error != nil = %t, want = %t", got, test.expectError)
+			}
+		})
+	}
+}
+
 func TestCIDRBlockToStringValue(t *testing.T) {
 	t.Parallel()
 
@@ -9,6 +9,7 @@ import (
 	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 // s3URIValidator validates that a string Attribute's value is a valid S3 URI.
@@ -27,7 +28,7 @@ func (validator s3URIValidator) ValidateString(ctx context.Context, request vali
 		return
 	}
 
-	if !regexache.MustCompile(`^s3://[a-z0-9][\.\-a-z0-9]{1,61}[a-z0-9](/.*)?$`).MatchString(request.ConfigValue.ValueString()) {
+	if !regexache.MustCompile(`^s3://` + verify.S3BucketNameRegexPattern + `(/.*)?$`).MatchString(request.ConfigValue.ValueString()) {
 		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
 			request.Path,
 			validator.Description(ctx),
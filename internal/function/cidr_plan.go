@@ -0,0 +1,136 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+var cidrPlanResultAttrTypes = map[string]attr.Type{
+	"cidr_block":           types.StringType,
+	"prefix_length":        types.Int64Type,
+	"first_usable_address": types.StringType,
+	"last_usable_address":  types.StringType,
+	"usable_address_count": types.NumberType,
+}
+
+var _ function.Function = cidrPlanFunction{}
+
+func NewCIDRPlanFunction() function.Function {
+	return &cidrPlanFunction{}
+}
+
+type cidrPlanFunction struct{}
+
+func (f cidrPlanFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_plan"
+}
+
+func (f cidrPlanFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "cidr_plan Function",
+		MarkdownDescription: "Allocates non-overlapping, aligned subnet CIDR blocks from a VPC CIDR block, accounting for the 5 addresses AWS reserves in each subnet. " +
+			"Requests are allocated in order, each at the lowest available address, so that appending requests does not change existing allocations.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "vpc_cidr",
+				CustomType:          fwtypes.CIDRBlockType,
+				MarkdownDescription: "IPv4 or IPv6 CIDR block of the VPC.",
+			},
+			function.ListParameter{
+				Name:                "requests",
+				ElementType:         types.MapType{ElemType: types.StringType},
+				MarkdownDescription: "List of subnet requests. Each request has a unique `name` and one of `prefix_length` or `min_hosts`.",
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.ObjectType{
+				AttrTypes: cidrPlanResultAttrTypes,
+			},
+		},
+	}
+}
+
+func (f cidrPlanFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var vpcCIDR fwtypes.CIDRBlock
+	var requests []map[string]string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &vpcCIDR, &requests))
+	if resp.Error != nil {
+		return
+	}
+
+	_, network, err := net.ParseCIDR(vpcCIDR.ValueString())
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	planner := newCIDRPlanner(network)
+	value := make(map[string]attr.Value, len(requests))
+
+	for i, request := range requests {
+		for k := range request {
+			switch k {
+			case "name", "prefix_length", "min_hosts":
+			default:
+				resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, fmt.Sprintf("request %d: unsupported key %q", i, k)))
+				return
+			}
+		}
+
+		name := request["name"]
+		if name == "" {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, fmt.Sprintf("request %d: name must be set", i)))
+			return
+		}
+		if _, ok := value[name]; ok {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, fmt.Sprintf("request %d: duplicate name %q", i, name)))
+			return
+		}
+
+		prefixLength, err := planner.prefixLength(request)
+		if err != nil {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, fmt.Sprintf("request %q: %s", name, err)))
+			return
+		}
+
+		subnet, err := planner.allocate(prefixLength)
+		if err != nil {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, fmt.Sprintf("request %q: %s", name, err)))
+			return
+		}
+
+		first, last, count := cidrPlanUsableAddresses(subnet)
+		result, d := types.ObjectValue(cidrPlanResultAttrTypes, map[string]attr.Value{
+			"cidr_block":           types.StringValue(subnet.String()),
+			"prefix_length":        types.Int64Value(int64(prefixLength)),
+			"first_usable_address": types.StringValue(first.String()),
+			"last_usable_address":  types.StringValue(last.String()),
+			"usable_address_count": types.NumberValue(new(big.Float).SetInt(count)),
+		})
+		if d.HasError() {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+			return
+		}
+
+		value[name] = result
+	}
+
+	result, d := types.MapValue(types.ObjectType{AttrTypes: cidrPlanResultAttrTypes}, value)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
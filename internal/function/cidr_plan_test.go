@@ -0,0 +1,101 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestCIDRPlanFunction_ipv4(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCIDRPlanFunctionConfig("10.0.0.0/16", `[
+    { name = "private", prefix_length = 24 },
+    { name = "public", min_hosts = 50 },
+  ]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("private_cidr_block", "10.0.0.0/24"),
+					resource.TestCheckOutput("public_cidr_block", "10.0.1.0/26"),
+					resource.TestCheckOutput("public_first_usable_address", "10.0.1.4"),
+					resource.TestCheckOutput("public_usable_address_count", "59"),
+				),
+			},
+		},
+	})
+}
+
+func TestCIDRPlanFunction_invalidVPCCIDR(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDRPlanFunctionConfig("10.0.0.1/16", `[{ name = "private", prefix_length = 24 }]`),
+				ExpectError: regexache.MustCompile(`not a valid CIDR block`),
+			},
+		},
+	})
+}
+
+func TestCIDRPlanFunction_exhausted(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCIDRPlanFunctionConfig("10.0.0.0/24", `[
+    { name = "private", prefix_length = 25 },
+    { name = "public", prefix_length = 24 },
+  ]`),
+				ExpectError: regexache.MustCompile(`no space left`),
+			},
+		},
+	})
+}
+
+func testCIDRPlanFunctionConfig(vpcCIDR, requests string) string {
+	return fmt.Sprintf(`
+locals {
+  test = provider::aws::cidr_plan(%[1]q, %[2]s)
+}
+
+output "private_cidr_block" {
+  value = local.test["private"].cidr_block
+}
+
+output "public_cidr_block" {
+  value = try(local.test["public"].cidr_block, null)
+}
+
+output "public_first_usable_address" {
+  value = try(local.test["public"].first_usable_address, null)
+}
+
+output "public_usable_address_count" {
+  value = try(local.test["public"].usable_address_count, null)
+}
+`, vpcCIDR, requests)
+}
@@ -0,0 +1,160 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"strconv"
+)
+
+const (
+	// cidrPlanReservedAddresses is the number of addresses AWS reserves in each subnet:
+	// the network address, the VPC router, the DNS server, one reserved for future use,
+	// and the last address.
+	cidrPlanReservedAddresses = 5
+
+	cidrPlanIPv4MinPrefixLength = 16
+	cidrPlanIPv4MaxPrefixLength = 28
+	cidrPlanIPv6MinPrefixLength = 44
+	cidrPlanIPv6MaxPrefixLength = 64
+	cidrPlanIPv6PrefixIncrement = 4
+)
+
+// cidrPlanner allocates subnets from a network. Allocations are kept in order of
+// start address.
+type cidrPlanner struct {
+	network   *net.IPNet
+	bits      int
+	allocated []*net.IPNet
+}
+
+func newCIDRPlanner(network *net.IPNet) *cidrPlanner {
+	_, bits := network.Mask.Size()
+
+	return &cidrPlanner{
+		network: network,
+		bits:    bits,
+	}
+}
+
+func (p *cidrPlanner) isIPv6() bool {
+	return p.bits == 8*net.IPv6len
+}
+
+// prefixLength returns the subnet prefix length for a request, validated against
+// the network and the subnet sizes supported by AWS.
+func (p *cidrPlanner) prefixLength(request map[string]string) (int, error) {
+	networkPrefixLength, _ := p.network.Mask.Size()
+	minPrefixLength, maxPrefixLength := max(networkPrefixLength, cidrPlanIPv4MinPrefixLength), cidrPlanIPv4MaxPrefixLength
+	if p.isIPv6() {
+		minPrefixLength, maxPrefixLength = max(networkPrefixLength, cidrPlanIPv6MinPrefixLength), cidrPlanIPv6MaxPrefixLength
+	}
+
+	v1, hasPrefixLength := request["prefix_length"]
+	v2, hasMinHosts := request["min_hosts"]
+
+	var prefixLength int
+	switch {
+	case hasPrefixLength && hasMinHosts:
+		return 0, errors.New("only one of prefix_length or min_hosts can be set")
+	case hasPrefixLength:
+		n, err := strconv.Atoi(v1)
+		if err != nil {
+			return 0, fmt.Errorf("prefix_length must be a whole number: %q", v1)
+		}
+		prefixLength = n
+	case hasMinHosts:
+		if p.isIPv6() {
+			return 0, errors.New("min_hosts is only supported for IPv4 networks, set prefix_length")
+		}
+		n, err := strconv.Atoi(v2)
+		if err != nil || n < 1 {
+			return 0, fmt.Errorf("min_hosts must be a positive whole number: %q", v2)
+		}
+		// Largest subnet prefix length with enough usable addresses.
+		prefixLength = p.bits
+		for prefixLength > 0 && (1<<(p.bits-prefixLength))-cidrPlanReservedAddresses < n {
+			prefixLength--
+		}
+		prefixLength = min(prefixLength, maxPrefixLength)
+	default:
+		return 0, errors.New("one of prefix_length or min_hosts must be set")
+	}
+
+	if prefixLength < minPrefixLength || prefixLength > maxPrefixLength {
+		return 0, fmt.Errorf("prefix length /%d must be between /%d and /%d", prefixLength, minPrefixLength, maxPrefixLength)
+	}
+	if p.isIPv6() && prefixLength%cidrPlanIPv6PrefixIncrement != 0 {
+		return 0, fmt.Errorf("IPv6 prefix length /%d must be a multiple of %d", prefixLength, cidrPlanIPv6PrefixIncrement)
+	}
+
+	return prefixLength, nil
+}
+
+// allocate returns the lowest aligned subnet with the specified prefix length
+// which does not overlap any existing allocation.
+func (p *cidrPlanner) allocate(prefixLength int) (*net.IPNet, error) {
+	size := new(big.Int).Lsh(big.NewInt(1), uint(p.bits-prefixLength))
+	start := new(big.Int).SetBytes(p.network.IP)
+	networkEnd := new(big.Int).Add(start, cidrPlanSize(p.network))
+
+	candidate := new(big.Int).Set(start)
+	for i := 0; ; {
+		end := new(big.Int).Add(candidate, size)
+		if end.Cmp(networkEnd) > 0 {
+			return nil, fmt.Errorf("no space left in %s for a /%d subnet", p.network, prefixLength)
+		}
+
+		// Skip allocations which end before the candidate.
+		for i < len(p.allocated) && cidrPlanEnd(p.allocated[i]).Cmp(candidate) <= 0 {
+			i++
+		}
+
+		if i == len(p.allocated) || new(big.Int).SetBytes(p.allocated[i].IP).Cmp(end) >= 0 {
+			subnet := &net.IPNet{
+				IP:   cidrPlanIP(candidate, p.bits),
+				Mask: net.CIDRMask(prefixLength, p.bits),
+			}
+			p.allocated = append(p.allocated[:i], append([]*net.IPNet{subnet}, p.allocated[i:]...)...)
+			return subnet, nil
+		}
+
+		// Move to the next aligned address after the overlapping allocation.
+		candidate = cidrPlanEnd(p.allocated[i])
+		if rem := new(big.Int).Mod(new(big.Int).Sub(candidate, start), size); rem.Sign() != 0 {
+			candidate.Add(candidate, new(big.Int).Sub(size, rem))
+		}
+	}
+}
+
+// cidrPlanUsableAddresses returns the first and last addresses of a subnet which
+// are not reserved by AWS, and the number of usable addresses.
+func cidrPlanUsableAddresses(subnet *net.IPNet) (net.IP, net.IP, *big.Int) {
+	_, bits := subnet.Mask.Size()
+	start := new(big.Int).SetBytes(subnet.IP)
+	end := cidrPlanEnd(subnet)
+
+	first := cidrPlanIP(new(big.Int).Add(start, big.NewInt(cidrPlanReservedAddresses-1)), bits)
+	last := cidrPlanIP(new(big.Int).Sub(end, big.NewInt(2)), bits)
+	count := new(big.Int).Sub(cidrPlanSize(subnet), big.NewInt(cidrPlanReservedAddresses))
+
+	return first, last, count
+}
+
+func cidrPlanSize(network *net.IPNet) *big.Int {
+	ones, bits := network.Mask.Size()
+	return new(big.Int).Lsh(big.NewInt(1), uint(bits-ones))
+}
+
+// cidrPlanEnd returns the address after the last address of the network.
+func cidrPlanEnd(network *net.IPNet) *big.Int {
+	return new(big.Int).Add(new(big.Int).SetBytes(network.IP), cidrPlanSize(network))
+}
+
+func cidrPlanIP(n *big.Int, bits int) net.IP {
+	return net.IP(n.FillBytes(make([]byte, bits/8)))
+}
@@ -0,0 +1,153 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"net"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCIDRPlanner(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		vpcCIDR     string
+		requests    []map[string]string
+		want        []string
+		expectError bool
+	}{
+		"IPv4 prefix lengths": {
+			vpcCIDR: "10.0.0.0/16",
+			requests: []map[string]string{
+				{"prefix_length": "24"},
+				{"prefix_length": "20"},
+				{"prefix_length": "24"},
+				{"prefix_length": "28"},
+			},
+			want: []string{"10.0.0.0/24", "10.0.16.0/20", "10.0.1.0/24", "10.0.2.0/28"},
+		},
+		"IPv4 min hosts": {
+			vpcCIDR: "10.0.0.0/16",
+			requests: []map[string]string{
+				{"min_hosts": "1"},
+				{"min_hosts": "11"},
+				{"min_hosts": "12"},
+				{"min_hosts": "251"},
+				{"min_hosts": "252"},
+			},
+			want: []string{"10.0.0.0/28", "10.0.0.16/28", "10.0.0.32/27", "10.0.1.0/24", "10.0.2.0/23"},
+		},
+		"IPv4 fills gaps": {
+			vpcCIDR: "10.0.0.0/24",
+			requests: []map[string]string{
+				{"prefix_length": "28"},
+				{"prefix_length": "26"},
+				{"prefix_length": "28"},
+				{"prefix_length": "27"},
+			},
+			want: []string{"10.0.0.0/28", "10.0.0.64/26", "10.0.0.16/28", "10.0.0.32/27"},
+		},
+		"IPv4 exhausted": {
+			vpcCIDR: "10.0.0.0/24",
+			requests: []map[string]string{
+				{"prefix_length": "25"},
+				{"prefix_length": "25"},
+				{"prefix_length": "28"},
+			},
+			expectError: true,
+		},
+		"IPv4 prefix length too large": {
+			vpcCIDR:     "10.0.0.0/16",
+			requests:    []map[string]string{{"prefix_length": "29"}},
+			expectError: true,
+		},
+		"IPv4 prefix length shorter than VPC": {
+			vpcCIDR:     "10.0.0.0/20",
+			requests:    []map[string]string{{"prefix_length": "18"}},
+			expectError: true,
+		},
+		"both prefix length and min hosts": {
+			vpcCIDR:     "10.0.0.0/16",
+			requests:    []map[string]string{{"prefix_length": "24", "min_hosts": "10"}},
+			expectError: true,
+		},
+		"IPv6": {
+			vpcCIDR: "2001:db8:1234:1a00::/56",
+			requests: []map[string]string{
+				{"prefix_length": "64"},
+				{"prefix_length": "60"},
+				{"prefix_length": "64"},
+			},
+			want: []string{"2001:db8:1234:1a00::/64", "2001:db8:1234:1a10::/60", "2001:db8:1234:1a01::/64"},
+		},
+		"IPv6 prefix length increment": {
+			vpcCIDR:     "2001:db8:1234:1a00::/56",
+			requests:    []map[string]string{{"prefix_length": "62"}},
+			expectError: true,
+		},
+		"IPv6 min hosts": {
+			vpcCIDR:     "2001:db8:1234:1a00::/56",
+			requests:    []map[string]string{{"min_hosts": "10"}},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, network, err := net.ParseCIDR(testCase.vpcCIDR)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			planner := newCIDRPlanner(network)
+			var got []string
+			for _, request := range testCase.requests {
+				var prefixLength int
+				var subnet *net.IPNet
+
+				prefixLength, err = planner.prefixLength(request)
+				if err != nil {
+					break
+				}
+				subnet, err = planner.allocate(prefixLength)
+				if err != nil {
+					break
+				}
+				got = append(got, subnet.String())
+			}
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("err %t, want %t: %v", got, want, err)
+			}
+			if err != nil {
+				return
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestCIDRPlanUsableAddresses(t *testing.T) {
+	t.Parallel()
+
+	_, subnet, _ := net.ParseCIDR("10.0.1.0/24")
+	first, last, count := cidrPlanUsableAddresses(subnet)
+
+	if got, want := first.String(), "10.0.1.4"; got != want {
+		t.Errorf("first = %s, want %s", got, want)
+	}
+	if got, want := last.String(), "10.0.1.254"; got != want {
+		t.Errorf("last = %s, want %s", got, want)
+	}
+	if got, want := count.Int64(), int64(251); got != want {
+		t.Errorf("count = %d, want %d", got, want)
+	}
+}
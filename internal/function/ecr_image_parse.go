@@ -0,0 +1,123 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var ecrImageParseResultAttrTypes = map[string]attr.Type{
+	"registry_id": types.StringType,
+	"region":      types.StringType,
+	"repository":  types.StringType,
+	"tag":         types.StringType,
+	"digest":      types.StringType,
+}
+
+var (
+	// ecrImageRegistryRegexp matches private ECR registry host names, e.g.
+	// "123456789012.dkr.ecr.us-west-2.amazonaws.com".
+	ecrImageRegistryRegexp   = regexache.MustCompile(`^([0-9]{12})\.dkr\.ecr(?:-fips)?\.([a-z0-9-]+)\.(?:amazonaws\.com(?:\.cn)?|c2s\.ic\.gov|sc2s\.sgov\.gov)$`)
+	ecrImageRepositoryRegexp = regexache.MustCompile(`^(?:[a-z0-9]+(?:[._-][a-z0-9]+)*/)*[a-z0-9]+(?:[._-][a-z0-9]+)*$`)
+	ecrImageTagRegexp        = regexache.MustCompile(`^[0-9A-Za-z_][0-9A-Za-z_.-]{0,127}$`)
+	ecrImageDigestRegexp     = regexache.MustCompile(`^sha256:[0-9a-f]{64}$`)
+)
+
+var _ function.Function = ecrImageParseFunction{}
+
+func NewECRImageParseFunction() function.Function {
+	return &ecrImageParseFunction{}
+}
+
+type ecrImageParseFunction struct{}
+
+func (f ecrImageParseFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ecr_image_parse"
+}
+
+func (f ecrImageParseFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "ecr_image_parse Function",
+		MarkdownDescription: "Parses a private Amazon ECR image reference into its registry ID, region, repository, tag and digest",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "image",
+				MarkdownDescription: "ECR image reference to parse, for example `123456789012.dkr.ecr.us-west-2.amazonaws.com/example:latest`",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: ecrImageParseResultAttrTypes,
+		},
+	}
+}
+
+func (f ecrImageParseFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	value, err := parseECRImage(arg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	result, d := types.ObjectValue(ecrImageParseResultAttrTypes, value)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// parseECRImage parses an image reference of the form
+// registry/repository[:tag][@digest].
+func parseECRImage(image string) (map[string]attr.Value, error) {
+	registry, rest, ok := strings.Cut(image, "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid ECR image %q: missing repository", image)
+	}
+
+	m := ecrImageRegistryRegexp.FindStringSubmatch(registry)
+	if m == nil {
+		return nil, fmt.Errorf("invalid ECR image %q: %q is not a private ECR registry", image, registry)
+	}
+	registryID, region := m[1], m[2]
+
+	repository, digest, _ := strings.Cut(rest, "@")
+	if digest != "" && !ecrImageDigestRegexp.MatchString(digest) {
+		return nil, fmt.Errorf("invalid ECR image %q: invalid digest %q", image, digest)
+	}
+
+	var tag string
+	if i := strings.LastIndex(repository, ":"); i >= 0 {
+		repository, tag = repository[:i], repository[i+1:]
+		if !ecrImageTagRegexp.MatchString(tag) {
+			return nil, fmt.Errorf("invalid ECR image %q: invalid tag %q", image, tag)
+		}
+	}
+
+	if !ecrImageRepositoryRegexp.MatchString(repository) {
+		return nil, fmt.Errorf("invalid ECR image %q: invalid repository name %q", image, repository)
+	}
+
+	return map[string]attr.Value{
+		"registry_id": types.StringValue(registryID),
+		"region":      types.StringValue(region),
+		"repository":  types.StringValue(repository),
+		"tag":         types.StringValue(tag),
+		"digest":      types.StringValue(digest),
+	}, nil
+}
@@ -0,0 +1,108 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestECRImageParseFunction_tag(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testECRImageParseFunctionConfig("444455556666.dkr.ecr.us-west-2.amazonaws.com/team/example:v1.2.3"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("registry_id", "444455556666"),
+					resource.TestCheckOutput("region", "us-west-2"),
+					resource.TestCheckOutput("repository", "team/example"),
+					resource.TestCheckOutput("tag", "v1.2.3"),
+					resource.TestCheckOutput("digest", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestECRImageParseFunction_digest(t *testing.T) {
+	t.Parallel()
+
+	const digest = "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testECRImageParseFunctionConfig("444455556666.dkr.ecr.eu-west-1.amazonaws.com/example:latest@" + digest),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("registry_id", "444455556666"),
+					resource.TestCheckOutput("region", "eu-west-1"),
+					resource.TestCheckOutput("repository", "example"),
+					resource.TestCheckOutput("tag", "latest"),
+					resource.TestCheckOutput("digest", digest),
+				),
+			},
+		},
+	})
+}
+
+func TestECRImageParseFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testECRImageParseFunctionConfig("public.ecr.aws/example/example:latest"),
+				ExpectError: regexache.MustCompile("is not a private ECR registry"),
+			},
+		},
+	})
+}
+
+func testECRImageParseFunctionConfig(arg string) string {
+	return fmt.Sprintf(`
+locals {
+  test = provider::aws::ecr_image_parse(%[1]q)
+}
+
+output "registry_id" {
+  value = local.test.registry_id
+}
+
+output "region" {
+  value = local.test.region
+}
+
+output "repository" {
+  value = local.test.repository
+}
+
+output "tag" {
+  value = local.test.tag
+}
+
+output "digest" {
+  value = local.test.digest
+}
+`, arg)
+}
@@ -0,0 +1,99 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
+)
+
+var _ function.Function = iamPolicyAllowsFunction{}
+
+func NewIAMPolicyAllowsFunction() function.Function {
+	return &iamPolicyAllowsFunction{}
+}
+
+type iamPolicyAllowsFunction struct{}
+
+func (f iamPolicyAllowsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_policy_allows"
+}
+
+func (f iamPolicyAllowsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "iam_policy_allows Function",
+		MarkdownDescription: "Evaluates whether a list of identity-based IAM policy documents allows an action on a resource. " +
+			"An explicit Deny overrides any Allow, and requests which are not explicitly allowed are implicitly denied.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "policies",
+				ElementType:         types.StringType,
+				MarkdownDescription: "List of identity-based IAM policy documents in JSON format. Empty strings are ignored.",
+			},
+			function.StringParameter{
+				Name:                "action",
+				MarkdownDescription: "Action to evaluate, for example `s3:GetObject`.",
+			},
+			function.StringParameter{
+				Name:                "resource",
+				MarkdownDescription: "ARN of the resource to evaluate.",
+			},
+			function.MapParameter{
+				Name:                "context",
+				ElementType:         types.StringType,
+				AllowNullValue:      true,
+				MarkdownDescription: "Map of condition keys to values, for example `aws:SourceIp`. Also used to resolve policy variables.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f iamPolicyAllowsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var policies []string
+	var action, resource string
+	var requestContext map[string]string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &policies, &action, &resource, &requestContext))
+	if resp.Error != nil {
+		return
+	}
+
+	if action == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, "action must be set"))
+		return
+	}
+
+	docs := make([]*iampolicy.Document, 0, len(policies))
+	for i, policy := range policies {
+		if policy == "" {
+			continue
+		}
+
+		doc, err := iampolicy.Parse(policy)
+		if err != nil {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, fmt.Sprintf("policy %d: %s", i, err)))
+			return
+		}
+
+		docs = append(docs, doc)
+	}
+
+	request := iamPolicyRequest{
+		action:   action,
+		resource: resource,
+		context:  requestContext,
+	}
+	decision, err := request.evaluate(docs)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, decision == iamPolicyDecisionAllow))
+}
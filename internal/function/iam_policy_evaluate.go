@@ -0,0 +1,392 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
+)
+
+type iamPolicyDecision int
+
+const (
+	iamPolicyDecisionImplicitDeny iamPolicyDecision = iota
+	iamPolicyDecisionAllow
+	iamPolicyDecisionExplicitDeny
+)
+
+// iamPolicyRequest is the request context evaluated against identity-based policies.
+type iamPolicyRequest struct {
+	action   string
+	resource string
+	context  map[string]string
+}
+
+// evaluate evaluates the request against the policy documents using the standard
+// IAM evaluation logic: an explicit Deny overrides any Allow, and a request which
+// is not explicitly allowed is implicitly denied.
+// Principal elements are ignored as only identity-based policies are evaluated.
+func (r iamPolicyRequest) evaluate(docs []*iampolicy.Document) (iamPolicyDecision, error) {
+	decision := iamPolicyDecisionImplicitDeny
+
+	for _, doc := range docs {
+		for i, statement := range doc.Statement {
+			matches, err := r.matches(statement)
+			if err != nil {
+				if sid := statement.Sid(); sid != "" {
+					return decision, fmt.Errorf("statement %q: %w", sid, err)
+				}
+				return decision, fmt.Errorf("statement %d: %w", i, err)
+			}
+
+			if !matches {
+				continue
+			}
+
+			switch effect, _ := statement["Effect"].(string); effect {
+			case "Deny":
+				return iamPolicyDecisionExplicitDeny, nil
+			case "Allow":
+				decision = iamPolicyDecisionAllow
+			default:
+				return decision, fmt.Errorf("unsupported Effect %q", effect)
+			}
+		}
+	}
+
+	return decision, nil
+}
+
+func (r iamPolicyRequest) matches(statement iampolicy.Statement) (bool, error) {
+	matchAction := func(pattern string) bool {
+		// Action names are case-insensitive.
+		return iamPolicyWildcardMatch(strings.ToLower(pattern), strings.ToLower(r.action))
+	}
+	matchResource := func(pattern string) bool {
+		return iamPolicyWildcardMatch(r.substituteVariables(pattern), r.resource)
+	}
+
+	ok, err := matchIAMPolicyElement(statement, "Action", "NotAction", matchAction)
+	if err != nil || !ok {
+		return false, err
+	}
+
+	ok, err = matchIAMPolicyElement(statement, "Resource", "NotResource", matchResource)
+	if err != nil || !ok {
+		return false, err
+	}
+
+	if v, ok := statement["Condition"]; ok {
+		conditions, ok := v.(map[string]any)
+		if !ok {
+			return false, errors.New("condition must be an object")
+		}
+
+		for operator, block := range conditions {
+			block, ok := block.(map[string]any)
+			if !ok {
+				return false, fmt.Errorf("condition %s must be an object", operator)
+			}
+
+			for key, values := range block {
+				ok, err := r.matchCondition(operator, key, iamPolicyStrings(values))
+				if err != nil || !ok {
+					return false, err
+				}
+			}
+		}
+	}
+
+	return true, nil
+}
+
+// matchIAMPolicyElement matches a statement's element, or its negated counterpart,
+// against the request.
+func matchIAMPolicyElement(statement iampolicy.Statement, element, notElement string, match func(string) bool) (bool, error) {
+	v, hasElement := statement[element]
+	notV, hasNotElement := statement[notElement]
+
+	switch {
+	case hasElement && hasNotElement:
+		return false, fmt.Errorf("%s and %s cannot both be specified", element, notElement)
+	case hasElement:
+		for _, pattern := range iamPolicyStrings(v) {
+			if match(pattern) {
+				return true, nil
+			}
+		}
+		return false, nil
+	case hasNotElement:
+		for _, pattern := range iamPolicyStrings(notV) {
+			if match(pattern) {
+				return false, nil
+			}
+		}
+		return true, nil
+	default:
+		return false, fmt.Errorf("one of %s or %s must be specified", element, notElement)
+	}
+}
+
+// iamPolicyConditionOperatorsMatchingMissingKey are the negated condition operators that
+// IAM documents as matching when the condition key is not present in the request context.
+var iamPolicyConditionOperatorsMatchingMissingKey = []string{
+	"StringNotEquals",
+	"StringNotEqualsIgnoreCase",
+	"StringNotLike",
+	"ArnNotEquals",
+	"ArnNotLike",
+}
+
+func (r iamPolicyRequest) matchCondition(operator, key string, values []string) (bool, error) {
+	baseOperator, ifExists := strings.CutSuffix(operator, "IfExists")
+
+	contextValue, ok := r.lookupContext(key)
+
+	if baseOperator == "Null" {
+		// "true" matches keys missing from the request context, "false" matches present keys.
+		for _, v := range values {
+			if strings.EqualFold(v, strconv.FormatBool(!ok)) {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+
+	if !ok {
+		// A missing key satisfies the IfExists variants and the negated string and ARN operators.
+		// All other operators, including NumericNotEquals, DateNotEquals and NotIpAddress, don't match.
+		return ifExists || slices.Contains(iamPolicyConditionOperatorsMatchingMissingKey, baseOperator), nil
+	}
+
+	var match func(string) (bool, error)
+	negate := false
+
+	switch baseOperator {
+	case "StringEquals", "StringNotEquals":
+		match = func(v string) (bool, error) { return contextValue == v, nil }
+		negate = baseOperator == "StringNotEquals"
+	case "StringEqualsIgnoreCase", "StringNotEqualsIgnoreCase":
+		match = func(v string) (bool, error) { return strings.EqualFold(contextValue, v), nil }
+		negate = baseOperator == "StringNotEqualsIgnoreCase"
+	case "StringLike", "StringNotLike":
+		match = func(v string) (bool, error) { return iamPolicyWildcardMatch(v, contextValue), nil }
+		negate = baseOperator == "StringNotLike"
+	case "ArnEquals", "ArnNotEquals", "ArnLike", "ArnNotLike":
+		match = func(v string) (bool, error) { return iamPolicyARNMatch(v, contextValue), nil }
+		negate = baseOperator == "ArnNotEquals" || baseOperator == "ArnNotLike"
+	case "IpAddress", "NotIpAddress":
+		ip := net.ParseIP(contextValue)
+		if ip == nil {
+			return false, fmt.Errorf("context key %s: invalid IP address %q", key, contextValue)
+		}
+		match = func(v string) (bool, error) {
+			if !strings.Contains(v, "/") {
+				return net.ParseIP(v).Equal(ip), nil
+			}
+			_, ipNet, err := net.ParseCIDR(v)
+			if err != nil {
+				return false, fmt.Errorf("condition %s: invalid CIDR block %q", operator, v)
+			}
+			return ipNet.Contains(ip), nil
+		}
+		negate = baseOperator == "NotIpAddress"
+	case "Bool":
+		match = func(v string) (bool, error) { return strings.EqualFold(contextValue, v), nil }
+	case "NumericEquals", "NumericNotEquals", "NumericLessThan", "NumericLessThanEquals", "NumericGreaterThan", "NumericGreaterThanEquals":
+		n, err := strconv.ParseFloat(contextValue, 64)
+		if err != nil {
+			return false, fmt.Errorf("context key %s: invalid number %q", key, contextValue)
+		}
+		match = func(v string) (bool, error) {
+			m, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				return false, fmt.Errorf("condition %s: invalid number %q", operator, v)
+			}
+			return compareIAMPolicyValues(baseOperator, "Numeric", n-m), nil
+		}
+		negate = baseOperator == "NumericNotEquals"
+	case "DateEquals", "DateNotEquals", "DateLessThan", "DateLessThanEquals", "DateGreaterThan", "DateGreaterThanEquals":
+		t, err := parseIAMPolicyDate(contextValue)
+		if err != nil {
+			return false, fmt.Errorf("context key %s: %w", key, err)
+		}
+		match = func(v string) (bool, error) {
+			u, err := parseIAMPolicyDate(v)
+			if err != nil {
+				return false, fmt.Errorf("condition %s: %w", operator, err)
+			}
+			return compareIAMPolicyValues(baseOperator, "Date", float64(t.Compare(u))), nil
+		}
+		negate = baseOperator == "DateNotEquals"
+	default:
+		return false, fmt.Errorf("unsupported condition operator %q", operator)
+	}
+
+	for _, v := range values {
+		ok, err := match(r.substituteVariables(v))
+		if err != nil {
+			return false, err
+		}
+		if ok {
+			return !negate, nil
+		}
+	}
+
+	return negate, nil
+}
+
+func (r iamPolicyRequest) lookupContext(key string) (string, bool) {
+	// Condition keys are case-insensitive.
+	for k, v := range r.context {
+		if strings.EqualFold(k, key) {
+			return v, true
+		}
+	}
+	return "", false
+}
+
+// substituteVariables replaces policy variables such as ${aws:username} with values
+// from the request context. Variables not present in the context are left unchanged.
+func (r iamPolicyRequest) substituteVariables(s string) string {
+	var sb strings.Builder
+
+	for {
+		start := strings.Index(s, "${")
+		if start < 0 {
+			break
+		}
+		end := strings.Index(s[start:], "}")
+		if end < 0 {
+			break
+		}
+		end += start
+
+		sb.WriteString(s[:start])
+		name := s[start+2 : end]
+		switch name {
+		case "*", "?", "$":
+			sb.WriteString(name)
+		default:
+			if v, ok := r.lookupContext(name); ok {
+				sb.WriteString(v)
+			} else {
+				sb.WriteString(s[start : end+1])
+			}
+		}
+		s = s[end+1:]
+	}
+	sb.WriteString(s)
+
+	return sb.String()
+}
+
+// compareIAMPolicyValues returns whether the comparison result (negative, zero or
+// positive) satisfies the operator.
+func compareIAMPolicyValues(operator, prefix string, cmp float64) bool {
+	switch strings.TrimPrefix(operator, prefix) {
+	case "Equals":
+		return cmp == 0
+	case "NotEquals":
+		// Negation is applied by the caller.
+		return cmp == 0
+	case "LessThan":
+		return cmp < 0
+	case "LessThanEquals":
+		return cmp <= 0
+	case "GreaterThan":
+		return cmp > 0
+	case "GreaterThanEquals":
+		return cmp >= 0
+	}
+	return false
+}
+
+func parseIAMPolicyDate(s string) (time.Time, error) {
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.Unix(n, 0), nil
+	}
+
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04:05Z0700", "2006-01-02"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid date %q", s)
+}
+
+// iamPolicyARNMatch matches an ARN against an ARN pattern. Each of the six ARN
+// components is matched separately so that wildcards do not span components.
+func iamPolicyARNMatch(pattern, arn string) bool {
+	patternParts := strings.SplitN(pattern, ":", 6)
+	arnParts := strings.SplitN(arn, ":", 6)
+
+	if len(patternParts) != 6 || len(arnParts) != 6 {
+		return iamPolicyWildcardMatch(pattern, arn)
+	}
+
+	for i := range patternParts {
+		if !iamPolicyWildcardMatch(patternParts[i], arnParts[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// iamPolicyWildcardMatch matches a string against a pattern in which "*" matches
+// any sequence of characters and "?" matches any single character.
+func iamPolicyWildcardMatch(pattern, s string) bool {
+	p, v := []rune(pattern), []rune(s)
+	pi, vi := 0, 0
+	star, mark := -1, 0
+
+	for vi < len(v) {
+		switch {
+		case pi < len(p) && (p[pi] == '?' || p[pi] == v[vi]):
+			pi++
+			vi++
+		case pi < len(p) && p[pi] == '*':
+			star, mark = pi, vi
+			pi++
+		case star >= 0:
+			pi = star + 1
+			mark++
+			vi = mark
+		default:
+			return false
+		}
+	}
+
+	for pi < len(p) && p[pi] == '*' {
+		pi++
+	}
+
+	return pi == len(p)
+}
+
+// iamPolicyStrings returns the string values of a policy element which may be
+// either a single value or a list of values.
+func iamPolicyStrings(v any) []string {
+	switch v := v.(type) {
+	case []any:
+		values := make([]string, 0, len(v))
+		for _, v := range v {
+			values = append(values, fmt.Sprint(v))
+		}
+		return values
+	case nil:
+		return nil
+	default:
+		return []string{fmt.Sprint(v)}
+	}
+}
@@ -0,0 +1,254 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
+)
+
+func TestIAMPolicyRequestEvaluate(t *testing.T) {
+	t.Parallel()
+
+	const resource = "arn:aws:s3:::example-bucket/home/alice/report.csv" // lintignore:AWSAT005
+
+	testCases := map[string]struct {
+		policies    []string
+		action      string
+		resource    string
+		context     map[string]string
+		want        iamPolicyDecision
+		expectError bool
+	}{
+		"no policies": {
+			action:   "s3:GetObject",
+			resource: resource,
+			want:     iamPolicyDecisionImplicitDeny,
+		},
+		"allow wildcard action": {
+			policies: []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"S3:Get*","Resource":"*"}]}`},
+			action:   "s3:GetObject",
+			resource: resource,
+			want:     iamPolicyDecisionAllow,
+		},
+		"allow other action": {
+			policies: []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:Get*","Resource":"*"}]}`},
+			action:   "s3:PutObject",
+			resource: resource,
+			want:     iamPolicyDecisionImplicitDeny,
+		},
+		"explicit deny overrides allow": {
+			policies: []string{
+				`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*"}]}`,
+				`{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:GetObject","Resource":"arn:aws:s3:::example-bucket/*"}]}`, // lintignore:AWSAT005
+			},
+			action:   "s3:GetObject",
+			resource: resource,
+			want:     iamPolicyDecisionExplicitDeny,
+		},
+		"not action": {
+			policies: []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","NotAction":"iam:*","Resource":"*"}]}`},
+			action:   "iam:CreateUser",
+			resource: "*",
+			want:     iamPolicyDecisionImplicitDeny,
+		},
+		"not resource": {
+			policies: []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:*","NotResource":"arn:aws:s3:::example-bucket/*"},{"Effect":"Allow","Action":"s3:*","Resource":"*"}]}`}, // lintignore:AWSAT005
+			action:   "s3:GetObject",
+			resource: resource,
+			want:     iamPolicyDecisionAllow,
+		},
+		"policy variable": {
+			policies: []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::example-bucket/home/${aws:username}/*"}]}`}, // lintignore:AWSAT005
+			action:   "s3:GetObject",
+			resource: resource,
+			context:  map[string]string{"aws:username": "alice"},
+			want:     iamPolicyDecisionAllow,
+		},
+		"condition IpAddress": {
+			policies: []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"IpAddress":{"aws:SourceIp":["10.0.0.0/8","192.0.2.1"]}}}]}`},
+			action:   "s3:GetObject",
+			resource: resource,
+			context:  map[string]string{"aws:SourceIp": "10.1.2.3"},
+			want:     iamPolicyDecisionAllow,
+		},
+		"condition key missing": {
+			policies: []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"StringEquals":{"aws:PrincipalTag/team":"data"}}}]}`},
+			action:   "s3:GetObject",
+			resource: resource,
+			want:     iamPolicyDecisionImplicitDeny,
+		},
+		"condition IfExists key missing": {
+			policies: []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"StringEqualsIfExists":{"aws:PrincipalTag/team":"data"}}}]}`},
+			action:   "s3:GetObject",
+			resource: resource,
+			want:     iamPolicyDecisionAllow,
+		},
+		"condition StringNotEquals key missing": {
+			policies: []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"StringNotEquals":{"aws:PrincipalTag/team":"data"}}}]}`},
+			action:   "s3:GetObject",
+			resource: resource,
+			want:     iamPolicyDecisionAllow,
+		},
+		"condition StringNotEqualsIgnoreCase key missing": {
+			policies: []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"StringNotEqualsIgnoreCase":{"aws:PrincipalTag/team":"data"}}}]}`},
+			action:   "s3:GetObject",
+			resource: resource,
+			want:     iamPolicyDecisionAllow,
+		},
+		"condition StringNotLike key missing": {
+			policies: []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"StringNotLike":{"aws:PrincipalTag/team":"data-*"}}}]}`},
+			action:   "s3:GetObject",
+			resource: resource,
+			want:     iamPolicyDecisionAllow,
+		},
+		"condition ArnNotEquals key missing": {
+			policies: []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"ArnNotEquals":{"aws:SourceArn":"arn:aws:lambda:us-west-2:123456789012:function:example"}}}]}`}, // lintignore:AWSAT003,AWSAT005
+			action:   "s3:GetObject",
+			resource: resource,
+			want:     iamPolicyDecisionAllow,
+		},
+		"condition ArnNotLike key missing": {
+			policies: []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"ArnNotLike":{"aws:SourceArn":"arn:aws:lambda:*:123456789012:function:*"}}}]}`}, // lintignore:AWSAT003,AWSAT005
+			action:   "s3:GetObject",
+			resource: resource,
+			want:     iamPolicyDecisionAllow,
+		},
+		"condition NumericNotEquals key missing": {
+			policies: []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"NumericNotEquals":{"s3:max-keys":"10"}}}]}`},
+			action:   "s3:GetObject",
+			resource: resource,
+			want:     iamPolicyDecisionImplicitDeny,
+		},
+		"condition DateNotEquals key missing": {
+			policies: []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"DateNotEquals":{"aws:CurrentTime":"2020-01-01T00:00:00Z"}}}]}`},
+			action:   "s3:GetObject",
+			resource: resource,
+			want:     iamPolicyDecisionImplicitDeny,
+		},
+		"condition NotIpAddress key missing": {
+			policies: []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"NotIpAddress":{"aws:SourceIp":"10.0.0.0/8"}}}]}`},
+			action:   "s3:GetObject",
+			resource: resource,
+			want:     iamPolicyDecisionImplicitDeny,
+		},
+		"condition StringLike ArnLike Bool": {
+			policies: []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"StringLike":{"aws:PrincipalTag/team":"data-*"},"ArnLike":{"aws:SourceArn":"arn:aws:lambda:*:123456789012:function:*"},"Bool":{"aws:SecureTransport":"true"}}}]}`}, // lintignore:AWSAT003,AWSAT005
+			action:   "s3:GetObject",
+			resource: resource,
+			context: map[string]string{
+				"aws:PrincipalTag/team": "data-eng",
+				"aws:SourceArn":         "arn:aws:lambda:us-west-2:123456789012:function:example", // lintignore:AWSAT003,AWSAT005
+				"aws:SecureTransport":   "True",
+			},
+			want: iamPolicyDecisionAllow,
+		},
+		"condition deny insecure transport": {
+			policies: []string{
+				`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+				`{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:*","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":"false"}}}]}`,
+			},
+			action:   "s3:GetObject",
+			resource: resource,
+			context:  map[string]string{"aws:SecureTransport": "false"},
+			want:     iamPolicyDecisionExplicitDeny,
+		},
+		"condition NumericLessThan DateGreaterThan": {
+			policies: []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"NumericLessThan":{"aws:MultiFactorAuthAge":3600},"DateGreaterThan":{"aws:CurrentTime":"2025-01-01T00:00:00Z"}}}]}`},
+			action:   "s3:GetObject",
+			resource: resource,
+			context: map[string]string{
+				"aws:MultiFactorAuthAge": "600",
+				"aws:CurrentTime":        "2025-06-01T12:00:00Z",
+			},
+			want: iamPolicyDecisionAllow,
+		},
+		"condition NumericLessThan not satisfied": {
+			policies: []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"NumericLessThan":{"aws:MultiFactorAuthAge":3600}}}]}`},
+			action:   "s3:GetObject",
+			resource: resource,
+			context:  map[string]string{"aws:MultiFactorAuthAge": "7200"},
+			want:     iamPolicyDecisionImplicitDeny,
+		},
+		"condition Null": {
+			policies: []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"Null":{"aws:TokenIssueTime":"true"}}}]}`},
+			action:   "s3:GetObject",
+			resource: resource,
+			want:     iamPolicyDecisionAllow,
+		},
+		"unsupported operator": {
+			policies:    []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"BinaryEquals":{"aws:SourceIp":"AAAA"}}}]}`},
+			action:      "s3:GetObject",
+			resource:    resource,
+			context:     map[string]string{"aws:SourceIp": "10.1.2.3"},
+			expectError: true,
+		},
+		"action and not action": {
+			policies:    []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","NotAction":"iam:*","Resource":"*"}]}`},
+			action:      "s3:GetObject",
+			resource:    resource,
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var docs []*iampolicy.Document
+			for _, policy := range testCase.policies {
+				doc, err := iampolicy.Parse(policy)
+				if err != nil {
+					t.Fatalf("Parse() err: %v", err)
+				}
+				docs = append(docs, doc)
+			}
+
+			request := iamPolicyRequest{
+				action:   testCase.action,
+				resource: testCase.resource,
+				context:  testCase.context,
+			}
+			got, err := request.evaluate(docs)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("evaluate() err %t, want %t: %v", got, want, err)
+			}
+			if err != nil {
+				return
+			}
+
+			if got != testCase.want {
+				t.Errorf("evaluate() = %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestIAMPolicyWildcardMatch(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		pattern string
+		s       string
+		want    bool
+	}{
+		{"*", "", true},
+		{"*", "anything", true},
+		{"s3:Get*", "s3:GetObject", true},
+		{"s3:Get*", "s3:PutObject", false},
+		{"s3:?etObject", "s3:GetObject", true},
+		{"a*b*c", "aXXbYYc", true},
+		{"a*b*c", "aXXbYY", false},
+		{"exact", "exact", true},
+		{"exact", "exactly", false},
+	}
+
+	for _, testCase := range testCases {
+		if got := iamPolicyWildcardMatch(testCase.pattern, testCase.s); got != testCase.want {
+			t.Errorf("iamPolicyWildcardMatch(%q, %q) = %t, want %t", testCase.pattern, testCase.s, got, testCase.want)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
+)
+
+var _ function.Function = iamPolicyMergeFunction{}
+
+func NewIAMPolicyMergeFunction() function.Function {
+	return &iamPolicyMergeFunction{}
+}
+
+type iamPolicyMergeFunction struct{}
+
+func (f iamPolicyMergeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_policy_merge"
+}
+
+func (f iamPolicyMergeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "iam_policy_merge Function",
+		MarkdownDescription: "Merges a list of IAM policy documents into a single normalized policy document. " +
+			"Statements with a Sid replace statements with the same Sid from earlier documents, as with the `override_policy_documents` argument of the `aws_iam_policy_document` data source.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "policies",
+				ElementType:         types.StringType,
+				MarkdownDescription: "List of IAM policy documents in JSON format. Empty strings are ignored.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f iamPolicyMergeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var policies []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &policies))
+	if resp.Error != nil {
+		return
+	}
+
+	mergedDoc, err := iampolicy.Merge(policies)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	if err := mergedDoc.Normalize(); err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	result, err := mergedDoc.String()
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,69 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestIAMPolicyMergeFunction_valid(t *testing.T) {
+	t.Parallel()
+	arg1 := `{"Version":"2012-10-17","Statement":[{"Sid":"Read","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`
+	arg2 := `{"Version":"2012-10-17","Statement":[{"Sid":"Read","Effect":"Allow","Action":["s3:ListBucket","s3:GetObject"],"Resource":"*"},{"Effect":"Deny","Action":"s3:DeleteObject","Resource":"*"}]}`
+	expected := `{"Version":"2012-10-17","Statement":[{"Action":["s3:GetObject","s3:ListBucket"],"Effect":"Allow","Resource":"*","Sid":"Read"},{"Action":"s3:DeleteObject","Effect":"Deny","Resource":"*"}]}`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyMergeFunctionConfig(arg1, arg2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", expected),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyMergeFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testIAMPolicyMergeFunctionConfig(`{"Version":"2012-10-17"}`, "invalid"),
+				ExpectError: regexache.MustCompile(`policy[\s\n]*1:[\s\n]*parsing[\s\n]*policy[\s\n]*document`),
+			},
+		},
+	})
+}
+
+func testIAMPolicyMergeFunctionConfig(args ...string) string {
+	quoted := make([]string, 0, len(args))
+	for _, arg := range args {
+		quoted = append(quoted, strconv.Quote(arg))
+	}
+
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::iam_policy_merge([%[1]s])
+}
+`, strings.Join(quoted, ", "))
+}
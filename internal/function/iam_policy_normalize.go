@@ -0,0 +1,65 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
+)
+
+var _ function.Function = iamPolicyNormalizeFunction{}
+
+func NewIAMPolicyNormalizeFunction() function.Function {
+	return &iamPolicyNormalizeFunction{}
+}
+
+type iamPolicyNormalizeFunction struct{}
+
+func (f iamPolicyNormalizeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_policy_normalize"
+}
+
+func (f iamPolicyNormalizeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "iam_policy_normalize Function",
+		MarkdownDescription: "Normalizes an IAM policy document. Value lists are sorted and deduplicated, single-element lists are replaced by the element, equivalent statements are removed, and statements are sorted by Sid and then by content.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "policy",
+				MarkdownDescription: "IAM policy document in JSON format.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f iamPolicyNormalizeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var policy string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &policy))
+	if resp.Error != nil {
+		return
+	}
+
+	doc, err := iampolicy.Parse(policy)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	if err := doc.Normalize(); err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	result, err := doc.String()
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
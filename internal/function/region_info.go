@@ -0,0 +1,134 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/dns"
+)
+
+var regionInfoResultAttrTypes = map[string]attr.Type{
+	"partition":                types.StringType,
+	"partition_name":           types.StringType,
+	"description":              types.StringType,
+	"dns_suffix":               types.StringType,
+	"reverse_dns_prefix":       types.StringType,
+	"dual_stack_dns_suffix":    types.StringType,
+	"service_principal_suffix": types.StringType,
+	"ec2_private_dns_suffix":   types.StringType,
+	"ec2_public_dns_suffix":    types.StringType,
+}
+
+// regionInfoPartitionData holds partition metadata that is not exported by the endpoints package.
+// The values are taken from aws-sdk-go-v2's partitions.json, which is only available in an internal package.
+// See https://github.com/aws/aws-sdk-go-v2/blob/main/internal/endpoints/awsrulesfn/partitions.json.
+var regionInfoPartitionData = map[string]struct {
+	dualStackDNSSuffix string
+}{
+	endpoints.AwsPartitionID:      {dualStackDNSSuffix: "api.aws"},
+	endpoints.AwsCnPartitionID:    {dualStackDNSSuffix: "api.amazonwebservices.com.cn"},
+	endpoints.AwsEuscPartitionID:  {dualStackDNSSuffix: "api.amazonwebservices.eu"},
+	endpoints.AwsIsoPartitionID:   {dualStackDNSSuffix: "api.aws.ic.gov"},
+	endpoints.AwsIsoBPartitionID:  {dualStackDNSSuffix: "api.aws.scloud"},
+	endpoints.AwsIsoEPartitionID:  {dualStackDNSSuffix: "api.cloud-aws.adc-e.uk"},
+	endpoints.AwsIsoFPartitionID:  {dualStackDNSSuffix: "api.aws.hci.ic.gov"},
+	endpoints.AwsUsGovPartitionID: {dualStackDNSSuffix: "api.aws"},
+}
+
+// regionInfoServicePrincipalSuffix is the default service principal DNS suffix, which is the same in every partition.
+// Some services use the partition's DNS suffix instead, see the aws_service_principal data source.
+const regionInfoServicePrincipalSuffix = "amazonaws.com"
+
+var _ function.Function = regionInfoFunction{}
+
+func NewRegionInfoFunction() function.Function {
+	return &regionInfoFunction{}
+}
+
+type regionInfoFunction struct{}
+
+func (f regionInfoFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "region_info"
+}
+
+func (f regionInfoFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "region_info Function",
+		MarkdownDescription: "Returns partition and DNS metadata for an AWS Region without calling AWS",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "region",
+				MarkdownDescription: "AWS Region, for example `us-west-2`",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: regionInfoResultAttrTypes,
+		},
+	}
+}
+
+func (f regionInfoFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var region string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &region))
+	if resp.Error != nil {
+		return
+	}
+
+	value, err := regionInfo(region)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	result, d := types.ObjectValue(regionInfoResultAttrTypes, value)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// regionInfo returns the metadata for the specified Region.
+// The DNS suffixes follow the corresponding conns.AWSClient methods.
+func regionInfo(region string) (map[string]attr.Value, error) {
+	partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region)
+	if !ok {
+		return nil, fmt.Errorf("unknown AWS Region %q", region)
+	}
+
+	dnsSuffix := partition.DNSSuffix()
+	if dnsSuffix == "" {
+		dnsSuffix = "amazonaws.com"
+	}
+
+	ec2PrivateDNSSuffix, ec2PublicDNSSuffix := region+".compute.internal", region+".compute."+dnsSuffix
+	if region == endpoints.UsEast1RegionID {
+		ec2PrivateDNSSuffix, ec2PublicDNSSuffix = "ec2.internal", "compute-1."+dnsSuffix
+	}
+
+	partitionData, ok := regionInfoPartitionData[partition.ID()]
+	if !ok {
+		return nil, fmt.Errorf("no metadata for AWS partition %q", partition.ID())
+	}
+
+	return map[string]attr.Value{
+		"partition":                types.StringValue(partition.ID()),
+		"partition_name":           types.StringValue(partition.Name()),
+		"description":              types.StringValue(partition.Regions()[region].Description()),
+		"dns_suffix":               types.StringValue(dnsSuffix),
+		"reverse_dns_prefix":       types.StringValue(dns.Reverse(dnsSuffix)),
+		"dual_stack_dns_suffix":    types.StringValue(partitionData.dualStackDNSSuffix),
+		"service_principal_suffix": types.StringValue(regionInfoServicePrincipalSuffix),
+		"ec2_private_dns_suffix":   types.StringValue(ec2PrivateDNSSuffix),
+		"ec2_public_dns_suffix":    types.StringValue(ec2PublicDNSSuffix),
+	}, nil
+}
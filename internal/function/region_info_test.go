@@ -0,0 +1,193 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestRegionInfoFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testRegionInfoFunctionConfig("us-west-2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("partition", "aws"),
+					resource.TestCheckOutput("dns_suffix", "amazonaws.com"),
+					resource.TestCheckOutput("reverse_dns_prefix", "com.amazonaws"),
+					resource.TestCheckOutput("dual_stack_dns_suffix", "api.aws"),
+					resource.TestCheckOutput("service_principal_suffix", "amazonaws.com"),
+					resource.TestCheckOutput("ec2_private_dns_suffix", "us-west-2.compute.internal"),
+					resource.TestCheckOutput("ec2_public_dns_suffix", "us-west-2.compute.amazonaws.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestRegionInfoFunction_usEast1(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testRegionInfoFunctionConfig("us-east-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("partition", "aws"),
+					resource.TestCheckOutput("ec2_private_dns_suffix", "ec2.internal"),
+					resource.TestCheckOutput("ec2_public_dns_suffix", "compute-1.amazonaws.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestRegionInfoFunction_china(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testRegionInfoFunctionConfig("cn-north-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("partition", "aws-cn"),
+					resource.TestCheckOutput("dns_suffix", "amazonaws.com.cn"),
+					resource.TestCheckOutput("reverse_dns_prefix", "cn.com.amazonaws"),
+					resource.TestCheckOutput("dual_stack_dns_suffix", "api.amazonwebservices.com.cn"),
+					resource.TestCheckOutput("service_principal_suffix", "amazonaws.com"),
+					resource.TestCheckOutput("ec2_public_dns_suffix", "cn-north-1.compute.amazonaws.com.cn"),
+				),
+			},
+		},
+	})
+}
+
+func TestRegionInfoFunction_partitions(t *testing.T) {
+	t.Parallel()
+
+	// Values from aws-sdk-go-v2's partitions.json.
+	testCases := map[string]struct {
+		dnsSuffix          string
+		dualStackDNSSuffix string
+	}{
+		endpoints.AwsPartitionID:      {dnsSuffix: "amazonaws.com", dualStackDNSSuffix: "api.aws"},
+		endpoints.AwsCnPartitionID:    {dnsSuffix: "amazonaws.com.cn", dualStackDNSSuffix: "api.amazonwebservices.com.cn"},
+		endpoints.AwsEuscPartitionID:  {dnsSuffix: "amazonaws.eu", dualStackDNSSuffix: "api.amazonwebservices.eu"},
+		endpoints.AwsIsoPartitionID:   {dnsSuffix: "c2s.ic.gov", dualStackDNSSuffix: "api.aws.ic.gov"},
+		endpoints.AwsIsoBPartitionID:  {dnsSuffix: "sc2s.sgov.gov", dualStackDNSSuffix: "api.aws.scloud"},
+		endpoints.AwsIsoEPartitionID:  {dnsSuffix: "cloud.adc-e.uk", dualStackDNSSuffix: "api.cloud-aws.adc-e.uk"},
+		endpoints.AwsIsoFPartitionID:  {dnsSuffix: "csp.hci.ic.gov", dualStackDNSSuffix: "api.aws.hci.ic.gov"},
+		endpoints.AwsUsGovPartitionID: {dnsSuffix: "amazonaws.com", dualStackDNSSuffix: "api.aws"},
+	}
+
+	for _, partition := range endpoints.DefaultPartitions() {
+		t.Run(partition.ID(), func(t *testing.T) {
+			t.Parallel()
+
+			testCase, ok := testCases[partition.ID()]
+			if !ok {
+				t.Fatalf("no test case for partition %q", partition.ID())
+			}
+
+			regions := slices.Sorted(maps.Keys(partition.Regions()))
+			if len(regions) == 0 {
+				t.Skipf("partition %q has no Regions", partition.ID())
+			}
+
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+				},
+				Steps: []resource.TestStep{
+					{
+						Config: testRegionInfoFunctionConfig(regions[0]),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckOutput("partition", partition.ID()),
+							resource.TestCheckOutput("dns_suffix", testCase.dnsSuffix),
+							resource.TestCheckOutput("dual_stack_dns_suffix", testCase.dualStackDNSSuffix),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestRegionInfoFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testRegionInfoFunctionConfig("invalid"),
+				ExpectError: regexache.MustCompile(`unknown AWS Region "invalid"`),
+			},
+		},
+	})
+}
+
+func testRegionInfoFunctionConfig(region string) string {
+	return fmt.Sprintf(`
+locals {
+  region_info = provider::aws::region_info(%[1]q)
+}
+
+output "partition" {
+  value = local.region_info.partition
+}
+
+output "dns_suffix" {
+  value = local.region_info.dns_suffix
+}
+
+output "reverse_dns_prefix" {
+  value = local.region_info.reverse_dns_prefix
+}
+
+output "dual_stack_dns_suffix" {
+  value = local.region_info.dual_stack_dns_suffix
+}
+
+output "service_principal_suffix" {
+  value = local.region_info.service_principal_suffix
+}
+
+output "ec2_private_dns_suffix" {
+  value = local.region_info.ec2_private_dns_suffix
+}
+
+output "ec2_public_dns_suffix" {
+  value = local.region_info.ec2_public_dns_suffix
+}
+`, region)
+}
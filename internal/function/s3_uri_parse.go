@@ -0,0 +1,129 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+var s3URIParseResultAttrTypes = map[string]attr.Type{
+	"bucket":     types.StringType,
+	"key":        types.StringType,
+	"version_id": types.StringType,
+}
+
+var (
+	s3URIBucketRegexp = regexache.MustCompile(`^` + verify.S3BucketNameRegexPattern + `$`)
+	// s3URIHostRegexp matches S3 endpoint host names, with an optional bucket
+	// for virtual-hosted-style URLs, e.g. "bucket.s3.us-west-2.amazonaws.com",
+	// "s3-us-west-2.amazonaws.com" and "bucket.s3.dualstack.us-west-2.amazonaws.com".
+	s3URIHostRegexp = regexache.MustCompile(`^(?:(.+)\.)?s3(?:-fips)?(?:[.-]dualstack)?(?:[.-][a-z0-9-]+)?\.amazonaws\.com(?:\.cn)?$`)
+)
+
+var _ function.Function = s3URIParseFunction{}
+
+func NewS3URIParseFunction() function.Function {
+	return &s3URIParseFunction{}
+}
+
+type s3URIParseFunction struct{}
+
+func (f s3URIParseFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "s3_uri_parse"
+}
+
+func (f s3URIParseFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "s3_uri_parse Function",
+		MarkdownDescription: "Parses an S3 URI or URL into its bucket, key and version ID. Supports `s3://` URIs, virtual-hosted-style URLs and path-style URLs.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "uri",
+				MarkdownDescription: "S3 URI or URL to parse",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: s3URIParseResultAttrTypes,
+		},
+	}
+}
+
+func (f s3URIParseFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	bucket, key, versionID, err := parseS3URI(arg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	value := map[string]attr.Value{
+		"bucket":     types.StringValue(bucket),
+		"key":        types.StringValue(key),
+		"version_id": types.StringValue(versionID),
+	}
+
+	result, d := types.ObjectValue(s3URIParseResultAttrTypes, value)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// parseS3URI returns the bucket, key and version ID of an S3 URI or URL.
+func parseS3URI(uri string) (string, string, string, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return "", "", "", fmt.Errorf("invalid S3 URI %q: %w", uri, err)
+	}
+
+	var bucket, key string
+	switch u.Scheme {
+	case "s3":
+		// Keys in s3:// URIs are not URL-encoded.
+		bucket = u.Host
+		key = strings.TrimPrefix(strings.TrimPrefix(uri, "s3://"+u.Host), "/")
+		if i := strings.Index(key, "?"); i >= 0 {
+			key = key[:i]
+		}
+	case "http", "https":
+		m := s3URIHostRegexp.FindStringSubmatch(u.Host)
+		if m == nil {
+			return "", "", "", fmt.Errorf("invalid S3 URI %q: host %q is not an S3 endpoint", uri, u.Host)
+		}
+
+		path := strings.TrimPrefix(u.Path, "/")
+		if m[1] != "" {
+			// Virtual-hosted-style.
+			bucket, key = m[1], path
+		} else {
+			// Path-style.
+			bucket, key, _ = strings.Cut(path, "/")
+		}
+	default:
+		return "", "", "", fmt.Errorf("invalid S3 URI %q: scheme must be one of s3, http or https", uri)
+	}
+
+	if !s3URIBucketRegexp.MatchString(bucket) {
+		return "", "", "", fmt.Errorf("invalid S3 URI %q: invalid bucket name %q", uri, bucket)
+	}
+
+	return bucket, key, u.Query().Get("versionId"), nil
+}
@@ -0,0 +1,115 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestS3URIParseFunction_s3URI(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testS3URIParseFunctionConfig("s3://amzn-s3-demo-bucket/path/to/object.txt"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("bucket", "amzn-s3-demo-bucket"),
+					resource.TestCheckOutput("key", "path/to/object.txt"),
+					resource.TestCheckOutput("version_id", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestS3URIParseFunction_virtualHosted(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testS3URIParseFunctionConfig("https://amzn-s3-demo-bucket.s3.us-west-2.amazonaws.com/path/to/object.txt?versionId=3HL4kqtJlcpXroDTDmJ.rmSpXd3dIbrHY"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("bucket", "amzn-s3-demo-bucket"),
+					resource.TestCheckOutput("key", "path/to/object.txt"),
+					resource.TestCheckOutput("version_id", "3HL4kqtJlcpXroDTDmJ.rmSpXd3dIbrHY"),
+				),
+			},
+		},
+	})
+}
+
+func TestS3URIParseFunction_pathStyle(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testS3URIParseFunctionConfig("https://s3.us-west-2.amazonaws.com/amzn-s3-demo-bucket/object.txt"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("bucket", "amzn-s3-demo-bucket"),
+					resource.TestCheckOutput("key", "object.txt"),
+					resource.TestCheckOutput("version_id", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestS3URIParseFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testS3URIParseFunctionConfig("https://example.com/object.txt"),
+				ExpectError: regexache.MustCompile("is not an S3 endpoint"),
+			},
+		},
+	})
+}
+
+func testS3URIParseFunctionConfig(arg string) string {
+	return fmt.Sprintf(`
+locals {
+  test = provider::aws::s3_uri_parse(%[1]q)
+}
+
+output "bucket" {
+  value = local.test.bucket
+}
+
+output "key" {
+  value = local.test.key
+}
+
+output "version_id" {
+  value = local.test.version_id
+}
+`, arg)
+}
@@ -0,0 +1,451 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/regexache"
+)
+
+var (
+	scheduleExpressionRegexp = regexache.MustCompile(`^(rate|cron|at)\((.*)\)$`)
+	cronNthDayOfWeekRegexp   = regexache.MustCompile(`^([^#]+)#([1-5])$`)
+)
+
+const (
+	cronMinYear = 1970
+	cronMaxYear = 2199
+)
+
+// scheduleExpression is a parsed EventBridge or EventBridge Scheduler schedule expression.
+type scheduleExpression interface {
+	// next returns up to n fire times strictly after the specified time.
+	next(after time.Time, n int) []time.Time
+}
+
+// parseScheduleExpression parses a rate(), cron() or at() schedule expression.
+// See https://docs.aws.amazon.com/scheduler/latest/UserGuide/schedule-types.html.
+func parseScheduleExpression(expr string) (scheduleExpression, error) {
+	m := scheduleExpressionRegexp.FindStringSubmatch(expr)
+	if m == nil {
+		return nil, fmt.Errorf("invalid schedule expression %q: must be one of rate(), cron() or at()", expr)
+	}
+
+	var (
+		schedule scheduleExpression
+		err      error
+	)
+	switch m[1] {
+	case "rate":
+		schedule, err = parseRateExpression(m[2])
+	case "cron":
+		schedule, err = parseCronExpression(m[2])
+	case "at":
+		schedule, err = parseAtExpression(m[2])
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid schedule expression %q: %w", expr, err)
+	}
+
+	return schedule, nil
+}
+
+type rateSchedule struct {
+	interval time.Duration
+}
+
+func parseRateExpression(s string) (*rateSchedule, error) {
+	fields := strings.Fields(s)
+	if len(fields) != 2 {
+		return nil, errors.New("rate expression must be of the form rate(value unit)")
+	}
+
+	value, err := strconv.Atoi(fields[0])
+	if err != nil || value < 1 {
+		return nil, fmt.Errorf("rate value (%s) must be a positive integer", fields[0])
+	}
+
+	unit := fields[1]
+	var d time.Duration
+	switch strings.TrimSuffix(unit, "s") {
+	case "minute":
+		d = time.Minute
+	case "hour":
+		d = time.Hour
+	case "day":
+		d = 24 * time.Hour
+	default:
+		return nil, fmt.Errorf("rate unit (%s) must be one of minute, minutes, hour, hours, day or days", unit)
+	}
+
+	if plural := strings.HasSuffix(unit, "s"); value == 1 && plural {
+		return nil, fmt.Errorf("rate unit (%s) must be singular when the value is 1", unit)
+	} else if value > 1 && !plural {
+		return nil, fmt.Errorf("rate unit (%s) must be plural when the value is greater than 1", unit)
+	}
+
+	return &rateSchedule{interval: time.Duration(value) * d}, nil
+}
+
+func (s *rateSchedule) next(after time.Time, n int) []time.Time {
+	var times []time.Time
+	t := after.UTC().Truncate(time.Second)
+	for range n {
+		t = t.Add(s.interval)
+		times = append(times, t)
+	}
+	return times
+}
+
+type atSchedule struct {
+	time time.Time
+}
+
+func parseAtExpression(s string) (*atSchedule, error) {
+	t, err := time.Parse("2006-01-02T15:04:05", s)
+	if err != nil {
+		return nil, fmt.Errorf("at expression must be of the form at(yyyy-mm-ddThh:mm:ss): %w", err)
+	}
+
+	return &atSchedule{time: t}, nil
+}
+
+func (s *atSchedule) next(after time.Time, n int) []time.Time {
+	if !s.time.After(after) {
+		return nil
+	}
+	return []time.Time{s.time}
+}
+
+// cronField describes one field of a cron expression.
+type cronField struct {
+	name     string
+	min, max int
+	// names are the optional symbolic names of the field's values, starting at min.
+	names []string
+}
+
+var (
+	cronMinutes    = cronField{name: "minutes", min: 0, max: 59}
+	cronHours      = cronField{name: "hours", min: 0, max: 23}
+	cronDayOfMonth = cronField{name: "day-of-month", min: 1, max: 31}
+	cronMonth      = cronField{name: "month", min: 1, max: 12, names: []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}}
+	cronDayOfWeek  = cronField{name: "day-of-week", min: 1, max: 7, names: []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}}
+	cronYear       = cronField{name: "year", min: cronMinYear, max: cronMaxYear}
+)
+
+type cronSchedule struct {
+	minutes, hours, months, years []bool
+
+	// Day-of-month matching. Unused if dayOfMonthAny is set.
+	dayOfMonthAny      bool
+	daysOfMonth        []bool
+	lastDayOfMonth     bool
+	lastWeekdayOfMonth bool
+	nearestWeekdays    []int
+
+	// Day-of-week matching. Unused if dayOfWeekAny is set.
+	dayOfWeekAny   bool
+	daysOfWeek     []bool
+	lastDaysOfWeek []int
+	nthDaysOfWeek  [][2]int
+}
+
+func parseCronExpression(s string) (*cronSchedule, error) {
+	fields := strings.Fields(s)
+	if len(fields) != 6 {
+		return nil, fmt.Errorf("cron expression must have 6 fields (minutes hours day-of-month month day-of-week year), got %d", len(fields))
+	}
+
+	if (fields[2] == "?") == (fields[4] == "?") {
+		return nil, errors.New("exactly one of the day-of-month and day-of-week fields must be ?")
+	}
+
+	var (
+		c   cronSchedule
+		err error
+	)
+	if c.minutes, err = cronMinutes.parse(fields[0]); err != nil {
+		return nil, err
+	}
+	if c.hours, err = cronHours.parse(fields[1]); err != nil {
+		return nil, err
+	}
+	if err = c.parseDayOfMonth(fields[2]); err != nil {
+		return nil, err
+	}
+	if c.months, err = cronMonth.parse(fields[3]); err != nil {
+		return nil, err
+	}
+	if err = c.parseDayOfWeek(fields[4]); err != nil {
+		return nil, err
+	}
+	if c.years, err = cronYear.parse(fields[5]); err != nil {
+		return nil, err
+	}
+
+	return &c, nil
+}
+
+func (c *cronSchedule) parseDayOfMonth(s string) error {
+	if s == "?" {
+		c.dayOfMonthAny = true
+		return nil
+	}
+
+	c.daysOfMonth = make([]bool, cronDayOfMonth.max+1)
+	for item := range strings.SplitSeq(s, ",") {
+		switch {
+		case item == "L":
+			c.lastDayOfMonth = true
+		case item == "LW":
+			c.lastWeekdayOfMonth = true
+		case strings.HasSuffix(item, "W"):
+			v, err := cronDayOfMonth.value(strings.TrimSuffix(item, "W"))
+			if err != nil {
+				return err
+			}
+			c.nearestWeekdays = append(c.nearestWeekdays, v)
+		default:
+			if err := cronDayOfMonth.parseItem(item, c.daysOfMonth); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (c *cronSchedule) parseDayOfWeek(s string) error {
+	if s == "?" {
+		c.dayOfWeekAny = true
+		return nil
+	}
+
+	c.daysOfWeek = make([]bool, cronDayOfWeek.max+1)
+	for item := range strings.SplitSeq(s, ",") {
+		if item == "L" {
+			// On its own, L is the last day of the week.
+			c.daysOfWeek[cronDayOfWeek.max] = true
+			continue
+		}
+
+		if m := cronNthDayOfWeekRegexp.FindStringSubmatch(item); m != nil {
+			v, err := cronDayOfWeek.value(m[1])
+			if err != nil {
+				return err
+			}
+			nth, _ := strconv.Atoi(m[2])
+			c.nthDaysOfWeek = append(c.nthDaysOfWeek, [2]int{v, nth})
+			continue
+		}
+
+		if v, ok := strings.CutSuffix(item, "L"); ok {
+			v, err := cronDayOfWeek.value(v)
+			if err != nil {
+				return err
+			}
+			c.lastDaysOfWeek = append(c.lastDaysOfWeek, v)
+			continue
+		}
+
+		if err := cronDayOfWeek.parseItem(item, c.daysOfWeek); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// parse returns the set of values matched by a comma-separated list of field items.
+func (f cronField) parse(s string) ([]bool, error) {
+	set := make([]bool, f.max+1)
+	for item := range strings.SplitSeq(s, ",") {
+		if err := f.parseItem(item, set); err != nil {
+			return nil, err
+		}
+	}
+	return set, nil
+}
+
+// parseItem adds the values matched by a single field item (*, v, a-b, */s, a/s or a-b/s) to set.
+func (f cronField) parseItem(item string, set []bool) error {
+	rng, step, hasStep := strings.Cut(item, "/")
+
+	increment := 1
+	if hasStep {
+		v, err := strconv.Atoi(step)
+		if err != nil || v < 1 {
+			return fmt.Errorf("invalid %s increment %q", f.name, step)
+		}
+		increment = v
+	}
+
+	var start, end int
+	switch {
+	case rng == "*":
+		start, end = f.min, f.max
+	case strings.Contains(rng, "-"):
+		a, b, _ := strings.Cut(rng, "-")
+		var err error
+		if start, err = f.value(a); err != nil {
+			return err
+		}
+		if end, err = f.value(b); err != nil {
+			return err
+		}
+		if start > end {
+			return fmt.Errorf("invalid %s range %q", f.name, rng)
+		}
+	default:
+		v, err := f.value(rng)
+		if err != nil {
+			return err
+		}
+		start, end = v, v
+		if hasStep {
+			end = f.max
+		}
+	}
+
+	for v := start; v <= end; v += increment {
+		set[v] = true
+	}
+
+	return nil
+}
+
+// value parses a single numeric or symbolic field value.
+func (f cronField) value(s string) (int, error) {
+	for i, name := range f.names {
+		if strings.EqualFold(s, name) {
+			return f.min + i, nil
+		}
+	}
+
+	v, err := strconv.Atoi(s)
+	if err != nil || v < f.min || v > f.max {
+		return 0, fmt.Errorf("invalid %s value %q: must be between %d and %d", f.name, s, f.min, f.max)
+	}
+
+	return v, nil
+}
+
+func (c *cronSchedule) next(after time.Time, n int) []time.Time {
+	var times []time.Time
+
+	// Fire times are on minute boundaries, strictly after the specified time.
+	t := after.UTC().Truncate(time.Minute).Add(time.Minute)
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	if day.Year() < cronMinYear {
+		day = time.Date(cronMinYear, time.January, 1, 0, 0, 0, 0, time.UTC)
+	}
+
+	for len(times) < n && day.Year() <= cronMaxYear {
+		switch {
+		case !c.years[day.Year()]:
+			day = time.Date(day.Year()+1, time.January, 1, 0, 0, 0, 0, time.UTC)
+		case !c.months[day.Month()]:
+			day = time.Date(day.Year(), day.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+		case !c.matchesDay(day):
+			day = day.AddDate(0, 0, 1)
+		default:
+			for hour := range 24 {
+				if !c.hours[hour] {
+					continue
+				}
+				for minute := range 60 {
+					if !c.minutes[minute] {
+						continue
+					}
+					if ts := day.Add(time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute); !ts.Before(t) && len(times) < n {
+						times = append(times, ts)
+					}
+				}
+			}
+			day = day.AddDate(0, 0, 1)
+		}
+	}
+
+	return times
+}
+
+func (c *cronSchedule) matchesDay(day time.Time) bool {
+	if c.dayOfMonthAny {
+		return c.matchesDayOfWeek(day)
+	}
+	return c.matchesDayOfMonth(day)
+}
+
+func (c *cronSchedule) matchesDayOfMonth(day time.Time) bool {
+	d, lastDay := day.Day(), daysInMonth(day)
+
+	if c.daysOfMonth[d] || (c.lastDayOfMonth && d == lastDay) {
+		return true
+	}
+
+	if c.lastWeekdayOfMonth && d == nearestWeekday(day, lastDay) {
+		return true
+	}
+
+	for _, v := range c.nearestWeekdays {
+		if v <= lastDay && d == nearestWeekday(day, v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c *cronSchedule) matchesDayOfWeek(day time.Time) bool {
+	// Cron days of the week are numbered from 1 (Sunday).
+	d, dow := day.Day(), int(day.Weekday())+1
+
+	if c.daysOfWeek[dow] {
+		return true
+	}
+
+	for _, v := range c.lastDaysOfWeek {
+		if dow == v && d+7 > daysInMonth(day) {
+			return true
+		}
+	}
+
+	for _, v := range c.nthDaysOfWeek {
+		if dow == v[0] && (d-1)/7+1 == v[1] {
+			return true
+		}
+	}
+
+	return false
+}
+
+func daysInMonth(day time.Time) int {
+	return time.Date(day.Year(), day.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// nearestWeekday returns the weekday (Monday to Friday) nearest to the specified
+// day of the month, without crossing into an adjacent month.
+func nearestWeekday(day time.Time, d int) int {
+	switch time.Date(day.Year(), day.Month(), d, 0, 0, 0, 0, time.UTC).Weekday() {
+	case time.Saturday:
+		if d == 1 {
+			return d + 2
+		}
+		return d - 1
+	case time.Sunday:
+		if d == daysInMonth(day) {
+			return d - 2
+		}
+		return d + 1
+	default:
+		return d
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// scheduleExpressionNextMaxCount is the maximum number of fire times that can be requested.
+const scheduleExpressionNextMaxCount = 1000
+
+var _ function.Function = scheduleExpressionNextFunction{}
+
+func NewScheduleExpressionNextFunction() function.Function {
+	return &scheduleExpressionNextFunction{}
+}
+
+type scheduleExpressionNextFunction struct{}
+
+func (f scheduleExpressionNextFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "schedule_expression_next"
+}
+
+func (f scheduleExpressionNextFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "schedule_expression_next Function",
+		MarkdownDescription: "Returns the next fire times, in UTC, of an Amazon EventBridge or EventBridge Scheduler `rate()`, `cron()` or `at()` schedule expression",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "expression",
+				MarkdownDescription: "Schedule expression",
+			},
+			function.Int64Parameter{
+				Name:                "count",
+				MarkdownDescription: "Number of fire times to return",
+			},
+			function.StringParameter{
+				Name:                "start_time",
+				MarkdownDescription: "RFC3339 timestamp after which fire times are calculated",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f scheduleExpressionNextFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var expression string
+	var count int64
+	var startTime string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &expression, &count, &startTime))
+	if resp.Error != nil {
+		return
+	}
+
+	schedule, err := parseScheduleExpression(expression)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	if count < 1 || count > scheduleExpressionNextMaxCount {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, "count must be between 1 and 1000"))
+		return
+	}
+
+	start, err := time.Parse(time.RFC3339, startTime)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(2, "start_time must be an RFC3339 timestamp: "+err.Error()))
+		return
+	}
+
+	var result []string
+	for _, t := range schedule.next(start, int(count)) {
+		result = append(result, t.Format(time.RFC3339))
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
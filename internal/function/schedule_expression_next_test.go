@@ -0,0 +1,78 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestScheduleExpressionNextFunction_cron(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testScheduleExpressionNextFunctionConfig("cron(0 9 ? * MON-FRI *)", 3, "2025-01-03T10:00:00Z"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "2025-01-06T09:00:00Z,2025-01-07T09:00:00Z,2025-01-08T09:00:00Z"),
+				),
+			},
+		},
+	})
+}
+
+func TestScheduleExpressionNextFunction_rate(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testScheduleExpressionNextFunctionConfig("rate(12 hours)", 2, "2025-01-01T00:00:00Z"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "2025-01-01T12:00:00Z,2025-01-02T00:00:00Z"),
+				),
+			},
+		},
+	})
+}
+
+func TestScheduleExpressionNextFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testScheduleExpressionNextFunctionConfig("rate(1 hours)", 1, "2025-01-01T00:00:00Z"),
+				ExpectError: regexache.MustCompile("must be singular"),
+			},
+		},
+	})
+}
+
+func testScheduleExpressionNextFunctionConfig(expr string, count int, startTime string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = join(",", provider::aws::schedule_expression_next(%[1]q, %[2]d, %[3]q))
+}
+`, expr, count, startTime)
+}
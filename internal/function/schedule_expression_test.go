@@ -0,0 +1,172 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestScheduleExpressionNext(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		expr        string
+		start       string
+		n           int
+		want        []string
+		expectError bool
+	}{
+		"invalid type": {
+			expr:        "every(5 minutes)",
+			expectError: true,
+		},
+		"rate singular": {
+			expr:  "rate(1 minute)",
+			start: "2025-01-01T00:00:30Z",
+			n:     2,
+			want:  []string{"2025-01-01T00:01:30Z", "2025-01-01T00:02:30Z"},
+		},
+		"rate plural": {
+			expr:  "rate(2 days)",
+			start: "2025-01-01T00:00:00Z",
+			n:     2,
+			want:  []string{"2025-01-03T00:00:00Z", "2025-01-05T00:00:00Z"},
+		},
+		"rate plural unit with value 1": {
+			expr:        "rate(1 days)",
+			expectError: true,
+		},
+		"rate singular unit with value 2": {
+			expr:        "rate(2 hour)",
+			expectError: true,
+		},
+		"rate zero": {
+			expr:        "rate(0 minutes)",
+			expectError: true,
+		},
+		"rate invalid unit": {
+			expr:        "rate(5 seconds)",
+			expectError: true,
+		},
+		"at future": {
+			expr:  "at(2025-06-01T12:30:00)",
+			start: "2025-01-01T00:00:00Z",
+			n:     3,
+			want:  []string{"2025-06-01T12:30:00Z"},
+		},
+		"at past": {
+			expr:  "at(2025-06-01T12:30:00)",
+			start: "2025-07-01T00:00:00Z",
+			n:     1,
+		},
+		"at invalid": {
+			expr:        "at(2025-06-01 12:30)",
+			expectError: true,
+		},
+		"cron every 15 minutes": {
+			expr:  "cron(0/15 * * * ? *)",
+			start: "2025-01-01T00:07:00Z",
+			n:     3,
+			want:  []string{"2025-01-01T00:15:00Z", "2025-01-01T00:30:00Z", "2025-01-01T00:45:00Z"},
+		},
+		"cron weekdays": {
+			expr:  "cron(0 18 ? * MON-FRI *)",
+			start: "2025-01-03T18:00:00Z",
+			n:     2,
+			want:  []string{"2025-01-06T18:00:00Z", "2025-01-07T18:00:00Z"},
+		},
+		"cron last day of month": {
+			expr:  "cron(0 0 L * ? *)",
+			start: "2024-01-31T00:00:00Z",
+			n:     2,
+			want:  []string{"2024-02-29T00:00:00Z", "2024-03-31T00:00:00Z"},
+		},
+		"cron last weekday of month": {
+			expr:  "cron(0 0 LW * ? *)",
+			start: "2025-05-01T00:00:00Z",
+			n:     1,
+			want:  []string{"2025-05-30T00:00:00Z"},
+		},
+		"cron nearest weekday": {
+			expr:  "cron(0 0 1W * ? *)",
+			start: "2025-02-15T00:00:00Z",
+			n:     2,
+			want:  []string{"2025-03-03T00:00:00Z", "2025-04-01T00:00:00Z"},
+		},
+		"cron nth day of week": {
+			expr:  "cron(30 2 ? * 2#1 *)",
+			start: "2025-01-01T00:00:00Z",
+			n:     2,
+			want:  []string{"2025-01-06T02:30:00Z", "2025-02-03T02:30:00Z"},
+		},
+		"cron last day of week": {
+			expr:  "cron(0 12 ? * 6L *)",
+			start: "2025-01-01T00:00:00Z",
+			n:     1,
+			want:  []string{"2025-01-31T12:00:00Z"},
+		},
+		"cron years": {
+			expr:  "cron(0 0 1 JAN ? 2026,2028)",
+			start: "2025-01-01T00:00:00Z",
+			n:     3,
+			want:  []string{"2026-01-01T00:00:00Z", "2028-01-01T00:00:00Z"},
+		},
+		"cron five fields": {
+			expr:        "cron(0 0 * * ?)",
+			expectError: true,
+		},
+		"cron both days specified": {
+			expr:        "cron(0 0 1 * MON *)",
+			expectError: true,
+		},
+		"cron neither day specified": {
+			expr:        "cron(0 0 ? * ? *)",
+			expectError: true,
+		},
+		"cron minute out of range": {
+			expr:        "cron(60 0 * * ? *)",
+			expectError: true,
+		},
+		"cron invalid month": {
+			expr:        "cron(0 0 * FOO ? *)",
+			expectError: true,
+		},
+		"cron reversed range": {
+			expr:        "cron(0 5-1 * * ? *)",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			schedule, err := parseScheduleExpression(testCase.expr)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("parseScheduleExpression(%q) err %t, want %t: %v", testCase.expr, got, want, err)
+			}
+			if err != nil {
+				return
+			}
+
+			start, err := time.Parse(time.RFC3339, testCase.start)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var got []string
+			for _, v := range schedule.next(start, testCase.n) {
+				got = append(got, v.Format(time.RFC3339))
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
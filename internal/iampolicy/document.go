@@ -0,0 +1,249 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Package iampolicy parses, merges and normalizes IAM policy documents.
+package iampolicy
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// Document is a generic representation of an IAM policy document.
+// Statement elements are kept as decoded JSON so that any element supported
+// by IAM round-trips unchanged.
+type Document struct {
+	Version   string      `json:",omitempty"`
+	Id        string      `json:",omitempty"`
+	Statement []Statement `json:",omitempty"`
+}
+
+// Statement is an IAM policy statement.
+type Statement map[string]any
+
+// Sid returns the statement's Sid, if any.
+func (s Statement) Sid() string {
+	v, _ := s["Sid"].(string)
+	return v
+}
+
+// String returns the statement in JSON format with its elements sorted.
+func (s Statement) String() string {
+	b, _ := json.Marshal(s)
+	return string(b)
+}
+
+// Parse decodes an IAM policy document. A single statement object is accepted
+// in place of a list of statements.
+func Parse(policy string) (*Document, error) {
+	decoder := json.NewDecoder(strings.NewReader(policy))
+	decoder.UseNumber()
+
+	var raw map[string]any
+	if err := decoder.Decode(&raw); err != nil {
+		return nil, fmt.Errorf("parsing policy document: %w", err)
+	}
+
+	doc := &Document{}
+	for k, v := range raw {
+		switch k {
+		case "Version":
+			s, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf("policy document Version must be a string")
+			}
+			doc.Version = s
+		case "Id":
+			s, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf("policy document Id must be a string")
+			}
+			doc.Id = s
+		case "Statement":
+			var statements []any
+			switch v := v.(type) {
+			case map[string]any:
+				statements = []any{v}
+			case []any:
+				statements = v
+			default:
+				return nil, fmt.Errorf("policy document Statement must be an object or a list of objects")
+			}
+
+			for i, v := range statements {
+				statement, ok := v.(map[string]any)
+				if !ok {
+					return nil, fmt.Errorf("policy document statement %d must be an object", i)
+				}
+				doc.Statement = append(doc.Statement, statement)
+			}
+		default:
+			return nil, fmt.Errorf("unsupported policy document element %q", k)
+		}
+	}
+
+	return doc, nil
+}
+
+// Merge parses and merges IAM policy documents in order. Empty documents are ignored.
+func Merge(policies []string) (*Document, error) {
+	doc := &Document{}
+
+	for i, policy := range policies {
+		if policy == "" {
+			continue
+		}
+
+		newDoc, err := Parse(policy)
+		if err != nil {
+			return nil, fmt.Errorf("policy %d: %w", i, err)
+		}
+
+		doc.Merge(newDoc)
+	}
+
+	return doc, nil
+}
+
+// Merge merges newDoc into the document in the same way as the aws_iam_policy_document
+// data source merges override_policy_documents: newDoc's Id is adopted, the later Version is kept,
+// statements with a Sid replace any existing statement with the same Sid, and all other statements are appended.
+func (d *Document) Merge(newDoc *Document) {
+	if newDoc.Id != "" {
+		d.Id = newDoc.Id
+	}
+
+	if newDoc.Version > d.Version {
+		d.Version = newDoc.Version
+	}
+
+	for _, statement := range newDoc.Statement {
+		sid := statement.Sid()
+		if i := slices.IndexFunc(d.Statement, func(s Statement) bool { return sid != "" && s.Sid() == sid }); i >= 0 {
+			d.Statement[i] = statement
+		} else {
+			d.Statement = append(d.Statement, statement)
+		}
+	}
+}
+
+// Normalize canonicalizes every statement, removes equivalent statements and
+// sorts statements by Sid and then by content. Distinct statements with the
+// same Sid are an error.
+func (d *Document) Normalize() error {
+	statements := make([]Statement, 0, len(d.Statement))
+	for _, statement := range d.Statement {
+		statement = normalizeStatement(statement)
+		if slices.ContainsFunc(statements, func(s Statement) bool { return statementsEquivalent(s, statement) }) {
+			continue
+		}
+		if sid := statement.Sid(); sid != "" && slices.ContainsFunc(statements, func(s Statement) bool { return s.Sid() == sid }) {
+			return fmt.Errorf("duplicate Sid (%s). Remove the Sid or ensure the Sid is unique", sid)
+		}
+		statements = append(statements, statement)
+	}
+	slices.SortStableFunc(statements, func(a, b Statement) int {
+		return cmp.Or(strings.Compare(a.Sid(), b.Sid()), strings.Compare(a.String(), b.String()))
+	})
+	d.Statement = statements
+
+	return nil
+}
+
+// String returns the document in JSON format with the Version element first, as
+// required by IAM.
+func (d *Document) String() (string, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+
+	if err := encoder.Encode(d); err != nil {
+		return "", err
+	}
+
+	return verify.LegacyPolicyNormalize(buf.String())
+}
+
+// statementsEquivalent returns whether two statements grant the same permissions.
+func statementsEquivalent(a, b Statement) bool {
+	policy := func(s Statement) string {
+		return fmt.Sprintf(`{"Version":"2012-10-17","Statement":[%s]}`, s)
+	}
+
+	return a.Sid() == b.Sid() && verify.PolicyStringsEquivalent(policy(a), policy(b))
+}
+
+// normalizeStatement returns a copy of the statement in which value lists
+// are sorted and deduplicated, and single-element lists are replaced by the element.
+func normalizeStatement(statement Statement) Statement {
+	result := make(Statement, len(statement))
+
+	for k, v := range statement {
+		switch k {
+		case "Sid":
+			if v == "" {
+				continue
+			}
+			result[k] = v
+		case "Action", "NotAction", "Resource", "NotResource":
+			result[k] = normalizeValues(v)
+		case "Principal", "NotPrincipal":
+			if m, ok := v.(map[string]any); ok {
+				principals := make(map[string]any, len(m))
+				for typ, identifiers := range m {
+					principals[typ] = normalizeValues(identifiers)
+				}
+				v = principals
+			}
+			result[k] = v
+		case "Condition":
+			if m, ok := v.(map[string]any); ok {
+				conditions := make(map[string]any, len(m))
+				for operator, block := range m {
+					if block, ok := block.(map[string]any); ok {
+						values := make(map[string]any, len(block))
+						for key, value := range block {
+							values[key] = normalizeValues(value)
+						}
+						conditions[operator] = values
+					} else {
+						conditions[operator] = block
+					}
+				}
+				v = conditions
+			}
+			result[k] = v
+		default:
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+func normalizeValues(v any) any {
+	values, ok := v.([]any)
+	if !ok {
+		return v
+	}
+
+	values = slices.Clone(values)
+	slices.SortStableFunc(values, func(a, b any) int {
+		return strings.Compare(fmt.Sprint(a), fmt.Sprint(b))
+	})
+	values = slices.CompactFunc(values, func(a, b any) bool {
+		return fmt.Sprint(a) == fmt.Sprint(b)
+	})
+
+	if len(values) == 1 {
+		return values[0]
+	}
+
+	return values
+}
@@ -0,0 +1,113 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package iampolicy_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
+)
+
+func TestDocumentNormalize(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		policy      string
+		want        string
+		expectError bool
+	}{
+		"invalid JSON": {
+			policy:      `{`,
+			expectError: true,
+		},
+		"unsupported element": {
+			policy:      `{"Version":"2012-10-17","Statements":[]}`,
+			expectError: true,
+		},
+		"single statement object": {
+			policy: `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["*"]}}`,
+			want:   `{"Version":"2012-10-17","Statement":[{"Action":"s3:GetObject","Effect":"Allow","Resource":"*"}]}`,
+		},
+		"sorted values": {
+			policy: `{"Statement":[{"Effect":"Allow","Action":["s3:PutObject","s3:GetObject","s3:GetObject"],"Resource":"*"}],"Version":"2012-10-17"}`,
+			want:   `{"Version":"2012-10-17","Statement":[{"Action":["s3:GetObject","s3:PutObject"],"Effect":"Allow","Resource":"*"}]}`,
+		},
+		"principals and conditions": {
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"sts:AssumeRole","Principal":{"AWS":["arn:aws:iam::222222222222:root","arn:aws:iam::111111111111:root"],"Service":["ec2.amazonaws.com"]},"Condition":{"StringEquals":{"aws:PrincipalTag/team":["b","a"]},"NumericLessThan":{"aws:MultiFactorAuthAge":[3600]}}}]}`,
+			want:   `{"Version":"2012-10-17","Statement":[{"Action":"sts:AssumeRole","Condition":{"NumericLessThan":{"aws:MultiFactorAuthAge":3600},"StringEquals":{"aws:PrincipalTag/team":["a","b"]}},"Effect":"Allow","Principal":{"AWS":["arn:aws:iam::111111111111:root","arn:aws:iam::222222222222:root"],"Service":"ec2.amazonaws.com"}}]}`,
+		},
+		"duplicate statements": {
+			policy: `{"Version":"2012-10-17","Statement":[{"Sid":"","Effect":"Allow","Action":"s3:GetObject","Resource":"*"},{"Effect":"Allow","Action":["s3:GetObject"],"Resource":"*"}]}`,
+			want:   `{"Version":"2012-10-17","Statement":[{"Action":"s3:GetObject","Effect":"Allow","Resource":"*"}]}`,
+		},
+		"sorted statements": {
+			policy: `{"Version":"2012-10-17","Statement":[{"Sid":"B","Effect":"Allow","Action":"s3:GetObject","Resource":"*"},{"Effect":"Deny","Action":"s3:PutObject","Resource":"*"},{"Sid":"A","Effect":"Allow","Action":"s3:ListBucket","Resource":"*"},{"Effect":"Allow","Action":"s3:PutObject","Resource":"*"}]}`,
+			want:   `{"Version":"2012-10-17","Statement":[{"Action":"s3:PutObject","Effect":"Allow","Resource":"*"},{"Action":"s3:PutObject","Effect":"Deny","Resource":"*"},{"Action":"s3:ListBucket","Effect":"Allow","Resource":"*","Sid":"A"},{"Action":"s3:GetObject","Effect":"Allow","Resource":"*","Sid":"B"}]}`,
+		},
+		"equivalent statements": {
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"sts:AssumeRole","Principal":{"AWS":"111111111111"}},{"Effect":"Allow","Action":"sts:AssumeRole","Principal":{"AWS":"arn:aws:iam::111111111111:root"}}]}`,
+			want:   `{"Version":"2012-10-17","Statement":[{"Action":"sts:AssumeRole","Effect":"Allow","Principal":{"AWS":"111111111111"}}]}`,
+		},
+		"duplicate Sid": {
+			policy:      `{"Version":"2012-10-17","Statement":[{"Sid":"A","Effect":"Allow","Action":"s3:GetObject","Resource":"*"},{"Sid":"A","Effect":"Deny","Action":"s3:GetObject","Resource":"*"}]}`,
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			doc, err := iampolicy.Parse(testCase.policy)
+			if err == nil {
+				err = doc.Normalize()
+			}
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("Normalize() err %t, want %t: %v", got, want, err)
+			}
+			if err != nil {
+				return
+			}
+
+			got, err := doc.String()
+			if err != nil {
+				t.Fatalf("String() err: %v", err)
+			}
+
+			if got != testCase.want {
+				t.Errorf("got %s, want %s", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestMerge(t *testing.T) {
+	t.Parallel()
+
+	policies := []string{
+		`{"Version":"2008-10-17","Id":"first","Statement":[{"Sid":"Read","Effect":"Allow","Action":"s3:GetObject","Resource":"*"},{"Effect":"Allow","Action":"s3:ListBucket","Resource":"*"}]}`,
+		`{"Version":"2012-10-17","Statement":[{"Sid":"Read","Effect":"Allow","Action":["s3:GetObjectVersion","s3:GetObject"],"Resource":"*"}]}`,
+		`{"Id":"last","Statement":[{"Effect":"Allow","Action":"s3:ListBucket","Resource":"*"},{"Effect":"Deny","Action":"s3:*","Resource":"*","Condition":{"NumericGreaterThan":{"aws:MultiFactorAuthAge":3600}}}]}`,
+	}
+	want := `{"Version":"2012-10-17","Id":"last","Statement":[{"Action":"s3:*","Condition":{"NumericGreaterThan":{"aws:MultiFactorAuthAge":3600}},"Effect":"Deny","Resource":"*"},{"Action":"s3:ListBucket","Effect":"Allow","Resource":"*"},{"Action":["s3:GetObject","s3:GetObjectVersion"],"Effect":"Allow","Resource":"*","Sid":"Read"}]}`
+
+	mergedDoc, err := iampolicy.Merge(policies)
+	if err != nil {
+		t.Fatalf("iampolicy.Merge() err: %v", err)
+	}
+
+	if err := mergedDoc.Normalize(); err != nil {
+		t.Fatalf("Normalize() err: %v", err)
+	}
+
+	got, err := mergedDoc.String()
+	if err != nil {
+		t.Fatalf("String() err: %v", err)
+	}
+
+	if got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
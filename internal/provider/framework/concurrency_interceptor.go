@@ -0,0 +1,51 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/interceptors"
+)
+
+var _ resourceCRUDInterceptor = concurrencyLimitInterceptor{}
+
+// concurrencyLimitInterceptor limits the number of in-flight resource mutations for a service package.
+type concurrencyLimitInterceptor struct {
+	resourceNoOpCRUDInterceptor
+	servicePackageName string
+}
+
+func (r concurrencyLimitInterceptor) create(ctx context.Context, opts interceptorOptions[resource.CreateRequest, resource.CreateResponse]) {
+	r.run(ctx, opts.c, opts.when, &opts.response.Diagnostics)
+}
+
+func (r concurrencyLimitInterceptor) update(ctx context.Context, opts interceptorOptions[resource.UpdateRequest, resource.UpdateResponse]) {
+	r.run(ctx, opts.c, opts.when, &opts.response.Diagnostics)
+}
+
+func (r concurrencyLimitInterceptor) delete(ctx context.Context, opts interceptorOptions[resource.DeleteRequest, resource.DeleteResponse]) {
+	r.run(ctx, opts.c, opts.when, &opts.response.Diagnostics)
+}
+
+func (r concurrencyLimitInterceptor) run(ctx context.Context, c awsClient, w when, diags *diag.Diagnostics) {
+	switch w {
+	case Before:
+		if err := interceptors.WaitConcurrencyLimit(ctx, c, r.servicePackageName); err != nil {
+			diags.AddError("Concurrency Limit", err.Error())
+		}
+	case Finally:
+		interceptors.NotifyConcurrencyLimit(ctx, c, r.servicePackageName)
+	}
+}
+
+// resourceConcurrencyLimit enforces any configured concurrency limit around resource Create, Update and Delete.
+// It must be the last interceptor run Before so that the limit is only acquired once no other Before interceptor can fail.
+func resourceConcurrencyLimit(servicePackageName string) resourceCRUDInterceptor {
+	return concurrencyLimitInterceptor{
+		servicePackageName: servicePackageName,
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
+)
+
+type mockConcurrencyLimitClient struct {
+	mockClient
+	limits map[string]tfsync.Semaphore
+}
+
+func (c mockConcurrencyLimitClient) ConcurrencyLimit(_ context.Context, servicePackageName string) (tfsync.Semaphore, bool) {
+	semaphore, ok := c.limits[servicePackageName]
+	return semaphore, ok
+}
+
+func TestConcurrencyLimitInterceptor(t *testing.T) {
+	t.Parallel()
+
+	semaphore := tfsync.NewSemaphore(1)
+	client := mockConcurrencyLimitClient{
+		limits: map[string]tfsync.Semaphore{
+			"route53": semaphore,
+		},
+	}
+	interceptors := interceptorInvocations{resourceConcurrencyLimit("route53")}
+
+	var inFlight int
+	f := func(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+		inFlight = len(semaphore)
+	}
+
+	handler := interceptedHandler(interceptors.resourceCreate(), f, resourceCreateHasError, client)
+
+	var response resource.CreateResponse
+	handler(t.Context(), resource.CreateRequest{}, &response)
+
+	if response.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", response.Diagnostics)
+	}
+	if got, want := inFlight, 1; got != want {
+		t.Errorf("in-flight during Create = %d, want %d", got, want)
+	}
+	if got, want := len(semaphore), 0; got != want {
+		t.Errorf("in-flight after Create = %d, want %d", got, want)
+	}
+}
+
+func TestConcurrencyLimitInterceptor_contextDone(t *testing.T) {
+	t.Parallel()
+
+	semaphore := tfsync.NewSemaphore(1)
+	semaphore.Wait()
+	client := mockConcurrencyLimitClient{
+		limits: map[string]tfsync.Semaphore{
+			"route53": semaphore,
+		},
+	}
+	interceptors := interceptorInvocations{resourceConcurrencyLimit("route53")}
+
+	var called bool
+	f := func(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+		called = true
+	}
+
+	handler := interceptedHandler(interceptors.resourceDelete(), f, resourceDeleteHasError, client)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	var response resource.DeleteResponse
+	handler(ctx, resource.DeleteRequest{}, &response)
+
+	if !response.Diagnostics.HasError() {
+		t.Error("expected error, got none")
+	}
+	if called {
+		t.Error("expected inner function to not be called")
+	}
+	if got, want := len(semaphore), 1; got != want {
+		t.Errorf("semaphore length = %d, want %d", got, want)
+	}
+}
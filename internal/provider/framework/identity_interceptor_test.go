@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/identity"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/resourceattribute"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -386,6 +387,10 @@ func (c mockClient) Region(_ context.Context) string {
 	return c.region
 }
 
+func (c mockClient) ConcurrencyLimit(_ context.Context, servicePackageName string) (tfsync.Semaphore, bool) {
+	return nil, false
+}
+
 func (c mockClient) DefaultTagsConfig(ctx context.Context) *tftags.DefaultConfig {
 	panic("not implemented") //lintignore:R009
 }
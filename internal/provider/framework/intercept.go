@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
 	tfiter "github.com/hashicorp/terraform-provider-aws/internal/iter"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -22,6 +23,7 @@ import (
 
 type awsClient interface {
 	AccountID(context.Context) string
+	ConcurrencyLimit(ctx context.Context, servicePackageName string) (tfsync.Semaphore, bool)
 	Region(context.Context) string
 	DefaultTagsConfig(ctx context.Context) *tftags.DefaultConfig
 	IgnoreTagsConfig(ctx context.Context) *tftags.IgnoreConfig
@@ -204,11 +204,23 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 			"tag_policy_compliance": schema.StringAttribute{
 				Optional: true,
 				Description: `The severity with which to enforce organizational tagging policies on resources managed by this provider instance. ` +
-					`At this time this only includes compliance with required tag keys by resource type. ` +
+					`This includes compliance with required tag keys by resource type, and with the tag key capitalization and allowed tag values defined in the effective tag policy. ` +
 					`Valid values are "error", "warning", and "disabled". ` +
 					`When unset or "disabled", tag policy compliance will not be enforced by the provider. ` +
 					`Can also be configured with the ` + tftags.TagPolicyComplianceEnvVar + ` environment variable.`,
 			},
+			"tag_policy_file": schema.StringAttribute{
+				Optional: true,
+				Description: `Path to a local JSON file containing a tag policy to enforce when tag policy compliance is enabled. ` +
+					`The file may use the tag policy syntax or contain an effective tag policy. ` +
+					`When set, the tag policy is read from the file in place of the organizations tag policies unless tag_policy_file_merge is true. ` +
+					`Can also be configured with the ` + tftags.TagPolicyFileEnvVar + ` environment variable.`,
+			},
+			"tag_policy_file_merge": schema.BoolAttribute{
+				Optional: true,
+				Description: `Whether to merge the tag policy read from tag_policy_file with the organizations tag policies ` +
+					`attached to the target account, rather than using it in their place.`,
+			},
 			"token": schema.StringAttribute{
 				Optional:    true,
 				Description: "session token. A session token is only required if you are\nusing temporary security credentials.",
@@ -315,6 +327,21 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 					},
 				},
 			},
+			"concurrency_limits": schema.ListNestedBlock{
+				Description: "Configuration blocks with settings to limit the number of concurrent resource mutations per service.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_inflight_mutations": schema.Int64Attribute{
+							Required:    true,
+							Description: "The maximum number of resource creates, updates and deletes for the service that can be in progress at once.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "The service, e.g. `route53`. Service aliases used in the `endpoints` block are accepted.",
+						},
+					},
+				},
+			},
 			"default_tags": schema.ListNestedBlock{
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(1),
@@ -354,6 +381,29 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 					},
 				},
 			},
+			"service_overrides": schema.ListNestedBlock{
+				Description: "Configuration blocks with settings that override the provider-level retry and rate limiting settings for a service.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_retries": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of times an AWS API request for the service is being executed.",
+						},
+						"retry_mode": schema.StringAttribute{
+							Optional:    true,
+							Description: "Specifies how retries are attempted for the service. Valid values are `standard` and `adaptive`.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "The service, e.g. `cloudformation`. Service aliases used in the `endpoints` block are accepted.",
+						},
+						"token_bucket_rate_limiter_capacity": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The capacity of the AWS SDK's token bucket rate limiter for the service.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -424,6 +474,14 @@ func (p *frameworkProvider) Functions(_ context.Context) []func() function.Funct
 	return []func() function.Function{
 		tffunction.NewARNBuildFunction,
 		tffunction.NewARNParseFunction,
+		tffunction.NewCIDRPlanFunction,
+		tffunction.NewECRImageParseFunction,
+		tffunction.NewIAMPolicyAllowsFunction,
+		tffunction.NewIAMPolicyMergeFunction,
+		tffunction.NewIAMPolicyNormalizeFunction,
+		tffunction.NewRegionInfoFunction,
+		tffunction.NewS3URIParseFunction,
+		tffunction.NewScheduleExpressionNextFunction,
 		tffunction.NewTrimIAMRolePathFunction,
 		tffunction.NewUserAgentFunction,
 	}
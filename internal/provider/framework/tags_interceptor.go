@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"slices"
+	"strings"
 	"unique"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -263,7 +264,8 @@ func resourceTransparentTagging(servicePackageResourceTags unique.Handle[inttype
 	}
 }
 
-// resourceValidateRequiredTags validates that required tags are present for a given resource type.
+// resourceValidateRequiredTags validates that required tags are present for a given resource type
+// and that tag keys and values comply with the effective tag policy.
 func resourceValidateRequiredTags() resourceModifyPlanInterceptor {
 	return &resourceValidateRequiredTagsInterceptor{}
 }
@@ -283,10 +285,19 @@ func (r resourceValidateRequiredTagsInterceptor) modifyPlan(ctx context.Context,
 		return
 	}
 	reqTags, ok := policy.RequiredTags[typeName]
-	if !ok {
+	if !ok && len(policy.Rules) == 0 {
 		return
 	}
 
+	addDiagnostic := func(severity, summary, detail string) {
+		switch severity {
+		case "warning":
+			opts.response.Diagnostics.AddAttributeWarning(path.Root(names.AttrTags), summary, detail)
+		default:
+			opts.response.Diagnostics.AddAttributeError(path.Root(names.AttrTags), summary, detail)
+		}
+	}
+
 	switch request, _, when := opts.request, opts.response, opts.when; when {
 	case Before:
 		// If the entire plan is null, the resource is planned for destruction.
@@ -315,21 +326,33 @@ func (r resourceValidateRequiredTagsInterceptor) modifyPlan(ctx context.Context,
 			return
 		}
 
-		if allPlanTags.ContainsAllKeys(reqTags) {
-			return
-		}
+		if !allPlanTags.ContainsAllKeys(reqTags) {
+			missing := reqTags.Removed(allPlanTags).Keys()
+			slices.Sort(missing)
 
-		missing := reqTags.Removed(allPlanTags).Keys()
-		slices.Sort(missing)
+			summary := "Missing Required Tags"
+			detail := fmt.Sprintf("An organizational tag policy requires the following tags for %s: %s", typeName, missing)
+			addDiagnostic(policy.Severity, summary, detail)
+		}
 
-		summary := "Missing Required Tags"
-		detail := fmt.Sprintf("An organizational tag policy requires the following tags for %s: %s", typeName, missing)
+		// Violations of rules which are not enforced for the resource type are only reported as warnings
+		var enforced, reported []string
+		for _, v := range policy.Violations(typeName, allPlanTags) {
+			if v.Enforced {
+				enforced = append(enforced, v.String())
+			} else {
+				reported = append(reported, v.String())
+			}
+		}
 
-		switch policy.Severity {
-		case "warning":
-			opts.response.Diagnostics.AddAttributeWarning(path.Root(names.AttrTags), summary, detail)
-		default:
-			opts.response.Diagnostics.AddAttributeError(path.Root(names.AttrTags), summary, detail)
+		summary := "Noncompliant Tags"
+		if len(enforced) > 0 {
+			detail := fmt.Sprintf("An organizational tag policy enforces the following tag rules for %s: %s", typeName, strings.Join(enforced, "; "))
+			addDiagnostic(policy.Severity, summary, detail)
+		}
+		if len(reported) > 0 {
+			detail := fmt.Sprintf("An organizational tag policy reports the following tags as noncompliant for %s: %s", typeName, strings.Join(reported, "; "))
+			addDiagnostic("warning", summary, detail)
 		}
 	}
 }
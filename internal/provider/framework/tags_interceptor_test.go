@@ -47,6 +47,27 @@ func (c mockRequiredTagsClient) TagPolicyConfig(ctx context.Context) *tftags.Tag
 	}
 }
 
+type mockTagPolicyRulesClient struct {
+	mockRequiredTagsClient
+}
+
+func (c mockTagPolicyRulesClient) TagPolicyConfig(ctx context.Context) *tftags.TagPolicyConfig {
+	return &tftags.TagPolicyConfig{
+		Severity: "error",
+		Rules: []tftags.TagPolicyRule{
+			{
+				Key:         "CostCenter",
+				Values:      []string{"100", "200"},
+				EnforcedFor: []string{"aws_test"},
+			},
+			{
+				Key:    "Environment",
+				Values: []string{"Prod*"},
+			},
+		},
+	}
+}
+
 type mockServicePackage struct{}
 
 func (sp mockServicePackage) FrameworkDataSources(context.Context) []*inttypes.ServicePackageFrameworkDataSource {
@@ -127,6 +148,26 @@ func Test_resourceValidateRequiredTagsInterceptor(t *testing.T) {
 	}
 	rawValUnknown := tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), attrsUnknown)
 
+	// Noncompliant tags
+	attrsNoncompliant := map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, "test"),
+		"tags": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"costcenter":  tftypes.NewValue(tftypes.String, "100"),
+			"Environment": tftypes.NewValue(tftypes.String, "Test"),
+		}),
+	}
+	rawValNoncompliant := tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), attrsNoncompliant)
+
+	// Compliant tags
+	attrsCompliant := map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, "test"),
+		"tags": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"CostCenter":  tftypes.NewValue(tftypes.String, "200"),
+			"Environment": tftypes.NewValue(tftypes.String, "Production"),
+		}),
+	}
+	rawValCompliant := tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), attrsCompliant)
+
 	tests := []struct {
 		name      string
 		opts      interceptorOptions[resource.ModifyPlanRequest, resource.ModifyPlanResponse]
@@ -366,6 +407,72 @@ func Test_resourceValidateRequiredTagsInterceptor(t *testing.T) {
 				when: Before,
 			},
 		},
+		{
+			name: "create, noncompliant tags",
+			opts: interceptorOptions[resource.ModifyPlanRequest, resource.ModifyPlanResponse]{
+				c: mockTagPolicyRulesClient{},
+				request: &resource.ModifyPlanRequest{
+					Config: tfsdk.Config{
+						Raw:    rawValNoncompliant,
+						Schema: resourceSchema,
+					},
+					State: tfsdk.State{
+						Raw:    tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), nil), // Raw state is null on creation
+						Schema: resourceSchema,
+					},
+					Plan: tfsdk.Plan{
+						Raw:    rawValNoncompliant,
+						Schema: resourceSchema,
+					},
+				},
+				response: &resource.ModifyPlanResponse{
+					Plan: tfsdk.Plan{
+						Raw:    rawValNoncompliant,
+						Schema: resourceSchema,
+					},
+				},
+				when: Before,
+			},
+			wantDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root(names.AttrTags),
+					"Noncompliant Tags",
+					`An organizational tag policy enforces the following tag rules for aws_test: tag key "costcenter" must be capitalized as "CostCenter"`,
+				),
+				diag.NewAttributeWarningDiagnostic(
+					path.Root(names.AttrTags),
+					"Noncompliant Tags",
+					`An organizational tag policy reports the following tags as noncompliant for aws_test: tag "Environment" has value "Test", allowed values: ["Prod*"]`,
+				),
+			},
+		},
+		{
+			name: "create, compliant tags",
+			opts: interceptorOptions[resource.ModifyPlanRequest, resource.ModifyPlanResponse]{
+				c: mockTagPolicyRulesClient{},
+				request: &resource.ModifyPlanRequest{
+					Config: tfsdk.Config{
+						Raw:    rawValCompliant,
+						Schema: resourceSchema,
+					},
+					State: tfsdk.State{
+						Raw:    tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), nil), // Raw state is null on creation
+						Schema: resourceSchema,
+					},
+					Plan: tfsdk.Plan{
+						Raw:    rawValCompliant,
+						Schema: resourceSchema,
+					},
+				},
+				response: &resource.ModifyPlanResponse{
+					Plan: tfsdk.Plan{
+						Raw:    rawValCompliant,
+						Schema: resourceSchema,
+					},
+				},
+				when: Before,
+			},
+		},
 		{
 			name: "destroy",
 			opts: interceptorOptions[resource.ModifyPlanRequest, resource.ModifyPlanResponse]{
@@ -563,6 +563,8 @@ func newWrappedResource(spec *inttypes.ServicePackageFrameworkResource, serviceP
 		interceptors = append(interceptors, resourceValidateRequiredTags())
 	}
 
+	interceptors = append(interceptors, resourceConcurrencyLimit(servicePackageName))
+
 	inner, _ := spec.Factory(context.TODO())
 
 	if len(spec.Identity.Attributes) == 0 {
@@ -0,0 +1,57 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package interceptors
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
+)
+
+type concurrencyLimitAWSClient interface {
+	ConcurrencyLimit(ctx context.Context, servicePackageName string) (tfsync.Semaphore, bool)
+}
+
+// WaitConcurrencyLimit waits until a resource mutation for the specified service package may proceed.
+// If the service package has no configured concurrency limit it returns immediately.
+// On success the caller is responsible for calling NotifyConcurrencyLimit for the same service package.
+func WaitConcurrencyLimit(ctx context.Context, c concurrencyLimitAWSClient, servicePackageName string) error {
+	semaphore, ok := c.ConcurrencyLimit(ctx, servicePackageName)
+	if !ok {
+		return nil
+	}
+
+	ctx = tflog.SetField(ctx, "concurrency_limit_service", servicePackageName)
+	ctx = tflog.SetField(ctx, "concurrency_limit", cap(semaphore))
+
+	start := time.Now()
+	err := semaphore.WaitContext(ctx)
+	wait := time.Since(start)
+
+	if err != nil {
+		tflog.Debug(ctx, "Concurrency limit not acquired", map[string]any{
+			"concurrency_limit_wait_duration": wait.String(),
+			"error":                           err.Error(),
+		})
+
+		return fmt.Errorf("waiting for %s concurrency limit (%d): %w", servicePackageName, cap(semaphore), err)
+	}
+
+	tflog.Debug(ctx, "Concurrency limit acquired", map[string]any{
+		"concurrency_limit_in_flight":     len(semaphore),
+		"concurrency_limit_wait_duration": wait.String(),
+	})
+
+	return nil
+}
+
+// NotifyConcurrencyLimit releases a resource mutation slot for the specified service package.
+func NotifyConcurrencyLimit(ctx context.Context, c concurrencyLimitAWSClient, servicePackageName string) {
+	if semaphore, ok := c.ConcurrencyLimit(ctx, servicePackageName); ok {
+		semaphore.Notify()
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/interceptors"
+)
+
+var _ crudInterceptor = concurrencyLimitInterceptor{}
+
+// concurrencyLimitInterceptor limits the number of in-flight resource mutations for a service package.
+type concurrencyLimitInterceptor struct {
+	servicePackageName string
+}
+
+func (r concurrencyLimitInterceptor) run(ctx context.Context, opts crudInterceptorOptions) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := opts.c
+
+	switch opts.when {
+	case Before:
+		if err := interceptors.WaitConcurrencyLimit(ctx, c, r.servicePackageName); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	case Finally:
+		interceptors.NotifyConcurrencyLimit(ctx, c, r.servicePackageName)
+	}
+
+	return diags
+}
+
+// resourceConcurrencyLimit enforces any configured concurrency limit around resource Create, Update and Delete.
+// It must be the last interceptor run Before so that the limit is only acquired once no other Before interceptor can fail.
+func resourceConcurrencyLimit(servicePackageName string) interceptorInvocation {
+	return interceptorInvocation{
+		when: Before | Finally,
+		why:  Create | Update | Delete,
+		interceptor: concurrencyLimitInterceptor{
+			servicePackageName: servicePackageName,
+		},
+	}
+}
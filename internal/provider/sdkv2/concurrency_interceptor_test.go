@@ -0,0 +1,149 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
+)
+
+type mockConcurrencyLimitClient struct {
+	mockClient
+	limits map[string]tfsync.Semaphore
+}
+
+func (c mockConcurrencyLimitClient) ConcurrencyLimit(_ context.Context, servicePackageName string) (tfsync.Semaphore, bool) {
+	semaphore, ok := c.limits[servicePackageName]
+	return semaphore, ok
+}
+
+func TestConcurrencyLimitInterceptor(t *testing.T) {
+	t.Parallel()
+
+	client := mockConcurrencyLimitClient{
+		limits: map[string]tfsync.Semaphore{
+			"route53": tfsync.NewSemaphore(2),
+		},
+	}
+
+	contextFunc := func(ctx context.Context, _ getAttributeFunc, _ getProviderMetaFunc, meta any) (context.Context, error) {
+		return ctx, nil
+	}
+
+	testCases := map[string]struct {
+		servicePackageName  string
+		why                 why
+		expectedMaxInFlight int32
+	}{
+		"limited Create": {
+			servicePackageName:  "route53",
+			why:                 Create,
+			expectedMaxInFlight: 2,
+		},
+		"limited Delete": {
+			servicePackageName:  "route53",
+			why:                 Delete,
+			expectedMaxInFlight: 2,
+		},
+		"unlimited Read": {
+			servicePackageName:  "route53",
+			why:                 Read,
+			expectedMaxInFlight: 8,
+		},
+		"unlimited service": {
+			servicePackageName:  "ec2",
+			why:                 Update,
+			expectedMaxInFlight: 8,
+		},
+	}
+
+	for name, testCase := range testCases { //nolint:paralleltest // Shares the client's semaphores
+		t.Run(name, func(t *testing.T) {
+			var inFlight, maxInFlight atomic.Int32
+			release := make(chan struct{})
+			f := func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+				n := inFlight.Add(1)
+				for {
+					m := maxInFlight.Load()
+					if n <= m || maxInFlight.CompareAndSwap(m, n) {
+						break
+					}
+				}
+				<-release
+				inFlight.Add(-1)
+				return nil
+			}
+
+			handler := interceptedCRUDHandler(contextFunc, interceptorInvocations{resourceConcurrencyLimit(testCase.servicePackageName)}, f, testCase.why)
+
+			ctx := t.Context()
+			var wg sync.WaitGroup
+			for range 8 {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+
+					if diags := handler(ctx, nil, client); diags.HasError() {
+						t.Errorf("unexpected error: %v", diags)
+					}
+				}()
+			}
+
+			// Wait until the expected number of calls are in flight before releasing them.
+			for maxInFlight.Load() < testCase.expectedMaxInFlight {
+				runtime.Gosched()
+			}
+			close(release)
+			wg.Wait()
+
+			if got, want := maxInFlight.Load(), testCase.expectedMaxInFlight; got != want {
+				t.Errorf("max in-flight = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestConcurrencyLimitInterceptor_contextDone(t *testing.T) {
+	t.Parallel()
+
+	semaphore := tfsync.NewSemaphore(1)
+	semaphore.Wait()
+	client := mockConcurrencyLimitClient{
+		limits: map[string]tfsync.Semaphore{
+			"route53": semaphore,
+		},
+	}
+
+	contextFunc := func(ctx context.Context, _ getAttributeFunc, _ getProviderMetaFunc, meta any) (context.Context, error) {
+		return ctx, nil
+	}
+
+	var called bool
+	f := func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		called = true
+		return nil
+	}
+
+	handler := interceptedCRUDHandler(contextFunc, interceptorInvocations{resourceConcurrencyLimit("route53")}, f, Create)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	if diags := handler(ctx, nil, client); !diags.HasError() {
+		t.Error("expected error, got none")
+	}
+	if called {
+		t.Error("expected inner function to not be called")
+	}
+	if got, want := len(semaphore), 1; got != want {
+		t.Errorf("semaphore length = %d, want %d", got, want)
+	}
+}
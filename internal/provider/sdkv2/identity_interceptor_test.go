@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2/identity"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2/internal/attribute"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -299,6 +300,10 @@ func (c mockClient) Region(_ context.Context) string {
 	return c.region
 }
 
+func (c mockClient) ConcurrencyLimit(_ context.Context, servicePackageName string) (tfsync.Semaphore, bool) {
+	return nil, false
+}
+
 func (c mockClient) DefaultTagsConfig(ctx context.Context) *tftags.DefaultConfig {
 	panic("not implemented") //lintignore:R009
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -20,6 +21,7 @@ import (
 
 type awsClient interface {
 	AccountID(ctx context.Context) string
+	ConcurrencyLimit(ctx context.Context, servicePackageName string) (tfsync.Semaphore, bool)
 	Region(ctx context.Context) string
 	DefaultTagsConfig(ctx context.Context) *tftags.DefaultConfig
 	IgnoreTagsConfig(ctx context.Context) *tftags.IgnoreConfig
@@ -75,6 +75,26 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 				},
 				"assume_role":                   assumeRoleSchema(),
 				"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+				"concurrency_limits": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "Configuration blocks with settings to limit the number of concurrent resource mutations per service.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"max_inflight_mutations": {
+								Type:         schema.TypeInt,
+								Required:     true,
+								ValidateFunc: validation.IntAtLeast(1),
+								Description:  "The maximum number of resource creates, updates and deletes for the service that can be in progress at once.",
+							},
+							"service": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "The service, e.g. `route53`. Service aliases used in the `endpoints` block are accepted.",
+							},
+						},
+					},
+				},
 				"custom_ca_bundle": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -212,6 +232,35 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Description: "The secret key for API operations. You can retrieve this\n" +
 						"from the 'Security & Credentials' section of the AWS console.",
 				},
+				"service_overrides": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "Configuration blocks with settings that override the provider-level retry and rate limiting settings for a service.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"max_retries": {
+								Type:        schema.TypeInt,
+								Optional:    true,
+								Description: "The maximum number of times an AWS API request for the service is being executed.",
+							},
+							"retry_mode": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "Specifies how retries are attempted for the service. Valid values are `standard` and `adaptive`.",
+							},
+							"service": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "The service, e.g. `cloudformation`. Service aliases used in the `endpoints` block are accepted.",
+							},
+							"token_bucket_rate_limiter_capacity": {
+								Type:        schema.TypeInt,
+								Optional:    true,
+								Description: "The capacity of the AWS SDK's token bucket rate limiter for the service.",
+							},
+						},
+					},
+				},
 				"shared_config_files": {
 					Type:        schema.TypeList,
 					Optional:    true,
@@ -259,11 +308,25 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Type:     schema.TypeString,
 					Optional: true,
 					Description: `The severity with which to enforce organizational tagging policies on resources managed by this provider instance. ` +
-						`At this time this only includes compliance with required tag keys by resource type. ` +
+						`This includes compliance with required tag keys by resource type, and with the tag key capitalization and allowed tag values defined in the effective tag policy. ` +
 						`Valid values are "error", "warning", and "disabled". ` +
 						`When unset or "disabled", tag policy compliance will not be enforced by the provider. ` +
 						`Can also be configured with the ` + tftags.TagPolicyComplianceEnvVar + ` environment variable.`,
 				},
+				"tag_policy_file": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Description: `Path to a local JSON file containing a tag policy to enforce when tag policy compliance is enabled. ` +
+						`The file may use the tag policy syntax or contain an effective tag policy. ` +
+						`When set, the tag policy is read from the file in place of the organizations tag policies unless tag_policy_file_merge is true. ` +
+						`Can also be configured with the ` + tftags.TagPolicyFileEnvVar + ` environment variable.`,
+				},
+				"tag_policy_file_merge": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Description: `Whether to merge the tag policy read from tag_policy_file with the organizations tag policies ` +
+						`attached to the target account, rather than using it in their place.`,
+				},
 				"token": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -463,6 +526,15 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 		config.DefaultTagsConfig = expandDefaultTags(ctx, nil)
 	}
 
+	if v, ok := d.GetOk("concurrency_limits"); ok && len(v.([]any)) > 0 {
+		limits, dg := expandConcurrencyLimits(cty.GetAttrPath("concurrency_limits"), v.([]any))
+		diags = append(diags, dg...)
+		if dg.HasError() {
+			return nil, diags
+		}
+		config.ConcurrencyLimits = limits
+	}
+
 	v := d.Get("endpoints")
 	endpoints, dx := expandEndpoints(ctx, v.(*schema.Set).List())
 	diags = append(diags, dx...)
@@ -503,10 +575,26 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 	}
 	config.TagPolicyConfig = tagCfg
 
+	if v, ok := d.Get("tag_policy_file").(string); ok && v != "" {
+		config.TagPolicyFile = v
+	} else {
+		config.TagPolicyFile = os.Getenv(tftags.TagPolicyFileEnvVar)
+	}
+	config.TagPolicyFileMerge = d.Get("tag_policy_file_merge").(bool)
+
 	if v, ok := d.GetOk("max_retries"); ok {
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("service_overrides"); ok && len(v.([]any)) > 0 {
+		overrides, dg := expandServiceOverrides(cty.GetAttrPath("service_overrides"), v.([]any))
+		diags = append(diags, dg...)
+		if dg.HasError() {
+			return nil, diags
+		}
+		config.ServiceOverrides = overrides
+	}
+
 	if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]any)) > 0 {
 		config.SharedCredentialsFiles = flex.ExpandStringValueList(v.([]any))
 	}
@@ -763,6 +851,8 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 				})
 			}
 
+			interceptors = append(interceptors, resourceConcurrencyLimit(servicePackageName))
+
 			if len(resource.Identity.Attributes) > 0 {
 				r.Identity = newResourceIdentity(resource.Identity)
 
@@ -1224,6 +1314,100 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]any) *tftags.IgnoreC
 	return ignoreConfig
 }
 
+func expandConcurrencyLimits(path cty.Path, tfList []any) (map[string]int, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	limits := make(map[string]int)
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		elementPath := path.IndexInt(i)
+		service := tfMap["service"].(string)
+
+		servicePackageName, err := names.ProviderPackageForAlias(service)
+		if err != nil {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(
+				elementPath.GetAttr("service"),
+				"Invalid Attribute Value",
+				fmt.Sprintf("Unsupported service %q", service),
+			))
+			continue
+		}
+
+		if _, ok := limits[servicePackageName]; ok {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(
+				elementPath.GetAttr("service"),
+				"Invalid Attribute Value",
+				fmt.Sprintf("Duplicate concurrency limit for service %q", service),
+			))
+			continue
+		}
+
+		limits[servicePackageName] = tfMap["max_inflight_mutations"].(int)
+	}
+
+	return limits, diags
+}
+
+func expandServiceOverrides(path cty.Path, tfList []any) (map[string]conns.ServiceOverride, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	overrides := make(map[string]conns.ServiceOverride)
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		elementPath := path.IndexInt(i)
+		service := tfMap["service"].(string)
+
+		servicePackageName, err := names.ProviderPackageForAlias(service)
+		if err != nil {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(
+				elementPath.GetAttr("service"),
+				"Invalid Attribute Value",
+				fmt.Sprintf("Unsupported service %q", service),
+			))
+			continue
+		}
+
+		if _, ok := overrides[servicePackageName]; ok {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(
+				elementPath.GetAttr("service"),
+				"Invalid Attribute Value",
+				fmt.Sprintf("Duplicate overrides for service %q", service),
+			))
+			continue
+		}
+
+		override := conns.ServiceOverride{
+			MaxRetries:                     tfMap["max_retries"].(int),
+			TokenBucketRateLimiterCapacity: tfMap["token_bucket_rate_limiter_capacity"].(int),
+		}
+
+		if v, ok := tfMap["retry_mode"].(string); ok && v != "" {
+			mode, err := aws.ParseRetryMode(v)
+			if err != nil {
+				diags = append(diags, errs.NewAttributeErrorDiagnostic(
+					elementPath.GetAttr("retry_mode"),
+					"Invalid Attribute Value",
+					err.Error(),
+				))
+				continue
+			}
+			override.RetryMode = mode
+		}
+
+		overrides[servicePackageName] = override
+	}
+
+	return overrides, diags
+}
+
 func expandTagPolicyConfig(path cty.Path, severity string) (*tftags.TagPolicyConfig, diag.Diagnostics) {
 	envSeverity := os.Getenv(tftags.TagPolicyComplianceEnvVar)
 	switch {
@@ -8,10 +8,13 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -425,6 +428,143 @@ func TestExpandIgnoreTags(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestExpandConcurrencyLimits(t *testing.T) {
+	t.Parallel()
+
+	path := cty.GetAttrPath("concurrency_limits")
+	testcases := map[string]struct {
+		tfList         []any
+		expectedLimits map[string]int
+		expectedDiags  diag.Diagnostics
+	}{
+		"empty": {
+			tfList:         []any{},
+			expectedLimits: map[string]int{},
+		},
+		"services": {
+			tfList: []any{
+				map[string]any{"service": "route53", "max_inflight_mutations": 2},
+				map[string]any{"service": "lambda", "max_inflight_mutations": 5},
+			},
+			expectedLimits: map[string]int{
+				names.Route53: 2,
+				names.Lambda:  5,
+			},
+		},
+		"alias": {
+			tfList: []any{
+				map[string]any{"service": "cloudwatchevents", "max_inflight_mutations": 1},
+			},
+			expectedLimits: map[string]int{
+				names.Events: 1,
+			},
+		},
+		"unsupported service": {
+			tfList: []any{
+				map[string]any{"service": "nosuchservice", "max_inflight_mutations": 1},
+			},
+			expectedLimits: map[string]int{},
+			expectedDiags: diag.Diagnostics{
+				errs.NewAttributeErrorDiagnostic(path.IndexInt(0).GetAttr("service"), "Invalid Attribute Value", `Unsupported service "nosuchservice"`),
+			},
+		},
+		"duplicate service": {
+			tfList: []any{
+				map[string]any{"service": "events", "max_inflight_mutations": 1},
+				map[string]any{"service": "cloudwatchevents", "max_inflight_mutations": 2},
+			},
+			expectedLimits: map[string]int{
+				names.Events: 1,
+			},
+			expectedDiags: diag.Diagnostics{
+				errs.NewAttributeErrorDiagnostic(path.IndexInt(1).GetAttr("service"), "Invalid Attribute Value", `Duplicate concurrency limit for service "cloudwatchevents"`),
+			},
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			limits, diags := expandConcurrencyLimits(path, testcase.tfList)
+
+			if diff := cmp.Diff(diags, testcase.expectedDiags, cmp.Comparer(sdkdiag.Comparer)); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+			if diff := cmp.Diff(limits, testcase.expectedLimits); diff != "" {
+				t.Errorf("unexpected limits difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandServiceOverrides(t *testing.T) {
+	t.Parallel()
+
+	path := cty.GetAttrPath("service_overrides")
+	testcases := map[string]struct {
+		tfList            []any
+		expectedOverrides map[string]conns.ServiceOverride
+		expectedDiags     diag.Diagnostics
+	}{
+		"services": {
+			tfList: []any{
+				map[string]any{"service": "cloudformation", "max_retries": 100, "retry_mode": "adaptive", "token_bucket_rate_limiter_capacity": 0},
+				map[string]any{"service": "route53", "max_retries": 0, "retry_mode": "", "token_bucket_rate_limiter_capacity": 1000},
+			},
+			expectedOverrides: map[string]conns.ServiceOverride{
+				names.CloudFormation: {MaxRetries: 100, RetryMode: aws.RetryModeAdaptive},
+				names.Route53:        {TokenBucketRateLimiterCapacity: 1000},
+			},
+		},
+		"unsupported service": {
+			tfList: []any{
+				map[string]any{"service": "nosuchservice", "max_retries": 1, "retry_mode": "", "token_bucket_rate_limiter_capacity": 0},
+			},
+			expectedOverrides: map[string]conns.ServiceOverride{},
+			expectedDiags: diag.Diagnostics{
+				errs.NewAttributeErrorDiagnostic(path.IndexInt(0).GetAttr("service"), "Invalid Attribute Value", `Unsupported service "nosuchservice"`),
+			},
+		},
+		"invalid retry mode": {
+			tfList: []any{
+				map[string]any{"service": "route53", "max_retries": 0, "retry_mode": "sometimes", "token_bucket_rate_limiter_capacity": 0},
+			},
+			expectedOverrides: map[string]conns.ServiceOverride{},
+			expectedDiags: diag.Diagnostics{
+				errs.NewAttributeErrorDiagnostic(path.IndexInt(0).GetAttr("retry_mode"), "Invalid Attribute Value", `unknown RetryMode, sometimes`),
+			},
+		},
+		"duplicate service": {
+			tfList: []any{
+				map[string]any{"service": "route53", "max_retries": 5, "retry_mode": "", "token_bucket_rate_limiter_capacity": 0},
+				map[string]any{"service": "route53", "max_retries": 10, "retry_mode": "", "token_bucket_rate_limiter_capacity": 0},
+			},
+			expectedOverrides: map[string]conns.ServiceOverride{
+				names.Route53: {MaxRetries: 5},
+			},
+			expectedDiags: diag.Diagnostics{
+				errs.NewAttributeErrorDiagnostic(path.IndexInt(1).GetAttr("service"), "Invalid Attribute Value", `Duplicate overrides for service "route53"`),
+			},
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			overrides, diags := expandServiceOverrides(path, testcase.tfList)
+
+			if diff := cmp.Diff(diags, testcase.expectedDiags, cmp.Comparer(sdkdiag.Comparer)); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+			if diff := cmp.Diff(overrides, testcase.expectedOverrides); diff != "" {
+				t.Errorf("unexpected overrides difference: %s", diff)
+			}
+		})
+	}
+}
+
 func stashEnv() []string {
 	env := os.Environ()
 	os.Clearenv()
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"slices"
+	"strings"
 	"unique"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -314,10 +315,26 @@ func validateRequiredTags() customizeDiffInterceptor {
 			return nil
 		}
 		reqTags, ok := policy.RequiredTags[typeName]
-		if !ok {
+		if !ok && len(policy.Rules) == 0 {
 			return nil
 		}
 
+		// CustomizeDiff does not support diagnostics (only an error return)
+		report := func(severity, summary, detail string) error {
+			switch severity {
+			case "warning":
+				// Warning diagnostics are only logged
+				tflog.Warn(ctx, "Required Tags Validation", map[string]any{
+					"summary": summary,
+					"detail":  detail,
+				})
+				return nil
+			default:
+				// Error diagnostics merge summary and detail into a single message
+				return fmt.Errorf("%s - %s", summary, detail)
+			}
+		}
+
 		switch d, when, why := opts.d, opts.when, opts.why; when {
 		case Before:
 			switch why {
@@ -335,26 +352,38 @@ func validateRequiredTags() customizeDiffInterceptor {
 
 				cfgTags := tftags.New(ctx, d.Get(names.AttrTags).(map[string]any))
 				allTags := c.DefaultTagsConfig(ctx).MergeTags(cfgTags)
-				if allTags.ContainsAllKeys(reqTags) {
-					return nil
+				if !allTags.ContainsAllKeys(reqTags) {
+					missing := reqTags.Removed(allTags).Keys()
+					slices.Sort(missing)
+					summary := "Missing Required Tags"
+					detail := fmt.Sprintf("An organizational tag policy requires the following tags for %s: %s", typeName, missing)
+					if err := report(policy.Severity, summary, detail); err != nil {
+						return err
+					}
 				}
 
-				missing := reqTags.Removed(allTags).Keys()
-				slices.Sort(missing)
-				summary := "Missing Required Tags"
-				detail := fmt.Sprintf("An organizational tag policy requires the following tags for %s: %s", typeName, missing)
-
-				// CustomizeDiff does not support diagnostics (only an error return)
-				switch policy.Severity {
-				case "warning":
-					// Warning diagnostics are only logged
-					tflog.Warn(ctx, "Required Tags Validation", map[string]any{
-						"summary": summary,
-						"detail":  detail,
-					})
-				default:
-					// Error diagnostics merge summary and detail into a single message
-					return fmt.Errorf("%s - %s", summary, detail)
+				// Violations of rules which are not enforced for the resource type are only reported as warnings
+				var enforced, reported []string
+				for _, v := range policy.Violations(typeName, allTags) {
+					if v.Enforced {
+						enforced = append(enforced, v.String())
+					} else {
+						reported = append(reported, v.String())
+					}
+				}
+
+				summary := "Noncompliant Tags"
+				if len(reported) > 0 {
+					detail := fmt.Sprintf("An organizational tag policy reports the following tags as noncompliant for %s: %s", typeName, strings.Join(reported, "; "))
+					if err := report("warning", summary, detail); err != nil {
+						return err
+					}
+				}
+				if len(enforced) > 0 {
+					detail := fmt.Sprintf("An organizational tag policy enforces the following tag rules for %s: %s", typeName, strings.Join(enforced, "; "))
+					if err := report(policy.Severity, summary, detail); err != nil {
+						return err
+					}
 				}
 			}
 		}
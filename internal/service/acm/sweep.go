@@ -17,7 +17,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_acm_certificate", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_acm_certificate", &resource.Sweeper{
 		Name: "aws_acm_certificate",
 		F:    sweepCertificates,
 		Dependencies: []string{
@@ -17,12 +17,12 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_appmesh_gateway_route", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_appmesh_gateway_route", &resource.Sweeper{
 		Name: "aws_appmesh_gateway_route",
 		F:    sweepGatewayRoutes,
 	})
 
-	resource.AddTestSweepers("aws_appmesh_mesh", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_appmesh_mesh", &resource.Sweeper{
 		Name: "aws_appmesh_mesh",
 		F:    sweepMeshes,
 		Dependencies: []string{
@@ -33,12 +33,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_appmesh_route", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_appmesh_route", &resource.Sweeper{
 		Name: "aws_appmesh_route",
 		F:    sweepRoutes,
 	})
 
-	resource.AddTestSweepers("aws_appmesh_virtual_gateway", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_appmesh_virtual_gateway", &resource.Sweeper{
 		Name: "aws_appmesh_virtual_gateway",
 		F:    sweepVirtualGateways,
 		Dependencies: []string{
@@ -46,12 +46,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_appmesh_virtual_node", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_appmesh_virtual_node", &resource.Sweeper{
 		Name: "aws_appmesh_virtual_node",
 		F:    sweepVirtualNodes,
 	})
 
-	resource.AddTestSweepers("aws_appmesh_virtual_router", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_appmesh_virtual_router", &resource.Sweeper{
 		Name: "aws_appmesh_virtual_router",
 		F:    sweepVirtualRouters,
 		Dependencies: []string{
@@ -59,7 +59,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_appmesh_virtual_service", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_appmesh_virtual_service", &resource.Sweeper{
 		Name: "aws_appmesh_virtual_service",
 		F:    sweepVirtualServices,
 	})
@@ -0,0 +1,187 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package autoscaling_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAutoScalingStartInstanceRefreshAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AutoScalingServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartInstanceRefreshActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceRefreshSuccessful(ctx, rName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAutoScalingStartInstanceRefreshAction_preferences(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AutoScalingServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartInstanceRefreshActionConfig_preferences(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceRefreshSuccessful(ctx, rName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAutoScalingStartInstanceRefreshAction_nonExistentGroup(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AutoScalingServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStartInstanceRefreshActionConfig_nonExistentGroup(rName),
+				ExpectError: regexache.MustCompile(`Failed to Start Instance Refresh`),
+			},
+		},
+	})
+}
+
+func testAccCheckInstanceRefreshSuccessful(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AutoScalingClient(ctx)
+
+		input := autoscaling.DescribeInstanceRefreshesInput{
+			AutoScalingGroupName: aws.String(name),
+		}
+
+		output, err := conn.DescribeInstanceRefreshes(ctx, &input)
+		if err != nil {
+			return fmt.Errorf("error describing instance refreshes for Auto Scaling Group %s: %w", name, err)
+		}
+
+		for _, v := range output.InstanceRefreshes {
+			if v.Status == awstypes.InstanceRefreshStatusSuccessful {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("no successful instance refresh found for Auto Scaling Group %s", name)
+	}
+}
+
+func testAccStartInstanceRefreshActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccGroupConfig_launchTemplate(rName),
+		`
+action "aws_autoscaling_start_instance_refresh" "test" {
+  config {
+    autoscaling_group_name = aws_autoscaling_group.test.name
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_autoscaling_start_instance_refresh.test]
+    }
+  }
+
+  depends_on = [aws_autoscaling_group.test]
+}
+`)
+}
+
+func testAccStartInstanceRefreshActionConfig_preferences(rName string) string {
+	return acctest.ConfigCompose(
+		testAccGroupConfig_launchTemplate(rName),
+		`
+action "aws_autoscaling_start_instance_refresh" "test" {
+  config {
+    autoscaling_group_name = aws_autoscaling_group.test.name
+    cancel_on_timeout      = true
+    timeout                = 1800
+
+    preferences {
+      auto_rollback          = true
+      checkpoint_delay       = 60
+      checkpoint_percentages = [50, 100]
+      instance_warmup        = 30
+      min_healthy_percentage = 50
+      skip_matching          = false
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_autoscaling_start_instance_refresh.test]
+    }
+  }
+
+  depends_on = [aws_autoscaling_group.test]
+}
+`)
+}
+
+func testAccStartInstanceRefreshActionConfig_nonExistentGroup(rName string) string {
+	return fmt.Sprintf(`
+action "aws_autoscaling_start_instance_refresh" "test" {
+  config {
+    autoscaling_group_name = %[1]q
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_autoscaling_start_instance_refresh.test]
+    }
+  }
+}
+`, rName)
+}
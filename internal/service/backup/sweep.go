@@ -18,12 +18,12 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_backup_framework", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_backup_framework", &resource.Sweeper{
 		Name: "aws_backup_framework",
 		F:    sweepFrameworks,
 	})
 
-	resource.AddTestSweepers("aws_backup_plan", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_backup_plan", &resource.Sweeper{
 		Name: "aws_backup_plan",
 		F:    sweepPlans,
 		Dependencies: []string{
@@ -31,17 +31,17 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_backup_selection", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_backup_selection", &resource.Sweeper{
 		Name: "aws_backup_selection",
 		F:    sweepSelections,
 	})
 
-	resource.AddTestSweepers("aws_backup_report_plan", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_backup_report_plan", &resource.Sweeper{
 		Name: "aws_backup_report_plan",
 		F:    sweepReportPlans,
 	})
 
-	resource.AddTestSweepers("aws_backup_restore_testing_plan", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_backup_restore_testing_plan", &resource.Sweeper{
 		Name: "aws_backup_restore_testing_plan",
 		F:    sweepRestoreTestingPlans,
 		Dependencies: []string{
@@ -49,27 +49,27 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_backup_restore_testing_selection", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_backup_restore_testing_selection", &resource.Sweeper{
 		Name: "aws_backup_restore_testing_selection",
 		F:    sweepRestoreTestingSelections,
 	})
 
-	resource.AddTestSweepers("aws_backup_vault_lock_configuration", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_backup_vault_lock_configuration", &resource.Sweeper{
 		Name: "aws_backup_vault_lock_configuration",
 		F:    sweepVaultLockConfigurations,
 	})
 
-	resource.AddTestSweepers("aws_backup_vault_notifications", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_backup_vault_notifications", &resource.Sweeper{
 		Name: "aws_backup_vault_notifications",
 		F:    sweepVaultNotifications,
 	})
 
-	resource.AddTestSweepers("aws_backup_vault_policy", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_backup_vault_policy", &resource.Sweeper{
 		Name: "aws_backup_vault_policy",
 		F:    sweepVaultPolicies,
 	})
 
-	resource.AddTestSweepers("aws_backup_vault", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_backup_vault", &resource.Sweeper{
 		Name: "aws_backup_vault",
 		F:    sweepVaults,
 		Dependencies: []string{
@@ -16,12 +16,12 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_budgets_budget_action", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_budgets_budget_action", &resource.Sweeper{
 		Name: "aws_budgets_budget_action",
 		F:    sweepBudgetActions,
 	})
 
-	resource.AddTestSweepers("aws_budgets_budget", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_budgets_budget", &resource.Sweeper{
 		Name: "aws_budgets_budget",
 		F:    sweepBudgets,
 		Dependencies: []string{
@@ -18,15 +18,15 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_cleanrooms_collaboration", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_cleanrooms_collaboration", &resource.Sweeper{
 		Name: "aws_cleanrooms_collaboration",
 		F:    sweepCollaborations,
 	})
-	resource.AddTestSweepers("aaws_cleanrooms_configured_table", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aaws_cleanrooms_configured_table", &resource.Sweeper{
 		Name: "aws_cleanrooms_configured_table",
 		F:    sweepConfiguredTables,
 	})
-	resource.AddTestSweepers("aaws_cleanrooms_membership", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aaws_cleanrooms_membership", &resource.Sweeper{
 		Name: "aws_cleanrooms_membership",
 		F:    sweepMemberships,
 	})
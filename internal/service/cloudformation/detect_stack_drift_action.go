@@ -0,0 +1,295 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudformation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// detectStackDriftPollInterval defines polling cadence for the detect stack drift action.
+const detectStackDriftPollInterval = 5 * time.Second
+
+// @Action(aws_cloudformation_detect_stack_drift, name="Detect Stack Drift")
+func newDetectStackDriftAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &detectStackDriftAction{}, nil
+}
+
+var (
+	_ action.Action = (*detectStackDriftAction)(nil)
+)
+
+type detectStackDriftAction struct {
+	framework.ActionWithModel[detectStackDriftModel]
+}
+
+type detectStackDriftModel struct {
+	framework.WithRegionModel
+	FailOnDrift        types.Bool           `tfsdk:"fail_on_drift" autoflex:"-"`
+	LogicalResourceIDs fwtypes.ListOfString `tfsdk:"logical_resource_ids"`
+	StackName          types.String         `tfsdk:"stack_name"`
+	Timeout            types.Int64          `tfsdk:"timeout" autoflex:"-"`
+}
+
+func (a *detectStackDriftAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Detects drift between a CloudFormation stack's template and the actual configuration of its resources.",
+		Attributes: map[string]schema.Attribute{
+			"fail_on_drift": schema.BoolAttribute{
+				Description: "Whether the action fails when the stack has drifted (default: false)",
+				Optional:    true,
+			},
+			"logical_resource_ids": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Description: "Logical IDs of the stack resources to check for drift. If not specified, all supported resources are checked",
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 200),
+				},
+			},
+			"stack_name": schema.StringAttribute{
+				Description: "Name or ID of the CloudFormation stack to check for drift",
+				Required:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for drift detection to complete (default: 1800)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(7200),
+				},
+			},
+		},
+	}
+}
+
+func (a *detectStackDriftAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config detectStackDriftModel
+
+	// Parse configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get AWS client
+	conn := a.Meta().CloudFormationClient(ctx)
+
+	stackName := config.StackName.ValueString()
+	failOnDrift := config.FailOnDrift.ValueBool()
+
+	// Set default timeout if not provided
+	timeout := 1800 * time.Second
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	var input cloudformation.DetectStackDriftInput
+	resp.Diagnostics.Append(fwflex.Expand(ctx, config, &input)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Starting CloudFormation detect stack drift action", map[string]any{
+		"stack_name":      stackName,
+		"fail_on_drift":   failOnDrift,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Starting drift detection for CloudFormation stack %s...", stackName),
+	})
+
+	output, err := conn.DetectStackDrift(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Detect Stack Drift",
+			fmt.Sprintf("Could not start drift detection for CloudFormation stack %s: %s", stackName, err),
+		)
+		return
+	}
+
+	detectionID := aws.ToString(output.StackDriftDetectionId)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Drift detection %s started, waiting for it to complete...", detectionID),
+	})
+
+	// DETECTION_FAILED means drift could not be checked for some resources; the stack drift status is still reported.
+	fr, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*cloudformation.DescribeStackDriftDetectionStatusOutput], error) {
+		output, err := findStackDriftDetectionStatusByID(ctx, conn, detectionID)
+		if err != nil {
+			return actionwait.FetchResult[*cloudformation.DescribeStackDriftDetectionStatusOutput]{}, fmt.Errorf("describing stack drift detection status: %w", err)
+		}
+		return actionwait.FetchResult[*cloudformation.DescribeStackDriftDetectionStatusOutput]{Status: actionwait.Status(output.DetectionStatus), Value: output}, nil
+	}, actionwait.Options[*cloudformation.DescribeStackDriftDetectionStatusOutput]{
+		Timeout:            timeout,
+		Interval:           actionwait.FixedInterval(detectStackDriftPollInterval),
+		ProgressInterval:   30 * time.Second,
+		SuccessStates:      []actionwait.Status{actionwait.Status(awstypes.StackDriftDetectionStatusDetectionComplete), actionwait.Status(awstypes.StackDriftDetectionStatusDetectionFailed)},
+		TransitionalStates: []actionwait.Status{actionwait.Status(awstypes.StackDriftDetectionStatusDetectionInProgress)},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("Drift detection %s is %s, continuing to wait for %s...", detectionID, fr.Status, awstypes.StackDriftDetectionStatusDetectionComplete),
+			})
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Stack Drift Detection",
+				fmt.Sprintf("Drift detection %s for CloudFormation stack %s did not complete within %s: %s", detectionID, stackName, timeout, err),
+			)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected Stack Drift Detection State",
+				fmt.Sprintf("Drift detection %s for CloudFormation stack %s entered unexpected state: %s", detectionID, stackName, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Stack Drift Detection",
+				fmt.Sprintf("Error while waiting for drift detection %s for CloudFormation stack %s: %s", detectionID, stackName, err),
+			)
+		}
+		return
+	}
+
+	if fr.Value.DetectionStatus == awstypes.StackDriftDetectionStatusDetectionFailed {
+		resp.Diagnostics.AddWarning(
+			"Stack Drift Detection Incomplete",
+			fmt.Sprintf("Drift detection %s for CloudFormation stack %s could not check all resources: %s", detectionID, stackName, aws.ToString(fr.Value.DetectionStatusReason)),
+		)
+	}
+
+	driftStatus := fr.Value.StackDriftStatus
+
+	if driftStatus != awstypes.StackDriftStatusDrifted {
+		// Final success message
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("CloudFormation stack %s drift status is %s", stackName, driftStatus),
+		})
+
+		tflog.Info(ctx, "CloudFormation detect stack drift action completed successfully", map[string]any{
+			"stack_name":         stackName,
+			"stack_drift_status": driftStatus,
+		})
+		return
+	}
+
+	drifts, err := findStackResourceDriftsByStackName(ctx, conn, stackName, awstypes.StackResourceDriftStatusModified, awstypes.StackResourceDriftStatusDeleted)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Describe Stack Resource Drifts",
+			fmt.Sprintf("Could not describe resource drifts for CloudFormation stack %s: %s", stackName, err),
+		)
+		return
+	}
+
+	details := make([]string, 0, len(drifts))
+	for _, drift := range drifts {
+		detail := stackResourceDriftDetail(drift)
+		details = append(details, detail)
+
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: detail,
+		})
+	}
+
+	if failOnDrift {
+		resp.Diagnostics.AddError(
+			"Stack Drift Detected",
+			fmt.Sprintf("CloudFormation stack %s has drifted: %d resources are modified or deleted.\n\n%s", stackName, aws.ToInt32(fr.Value.DriftedStackResourceCount), strings.Join(details, "\n")),
+		)
+		return
+	}
+
+	// Final success message
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("CloudFormation stack %s drift status is %s: %d resources are modified or deleted", stackName, driftStatus, aws.ToInt32(fr.Value.DriftedStackResourceCount)),
+	})
+
+	tflog.Info(ctx, "CloudFormation detect stack drift action completed successfully", map[string]any{
+		"stack_name":                   stackName,
+		"stack_drift_status":           driftStatus,
+		"drifted_stack_resource_count": aws.ToInt32(fr.Value.DriftedStackResourceCount),
+	})
+}
+
+func findStackDriftDetectionStatusByID(ctx context.Context, conn *cloudformation.Client, id string) (*cloudformation.DescribeStackDriftDetectionStatusOutput, error) {
+	input := cloudformation.DescribeStackDriftDetectionStatusInput{
+		StackDriftDetectionId: aws.String(id),
+	}
+
+	output, err := conn.DescribeStackDriftDetectionStatus(ctx, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findStackResourceDriftsByStackName(ctx context.Context, conn *cloudformation.Client, stackName string, statuses ...awstypes.StackResourceDriftStatus) ([]awstypes.StackResourceDrift, error) {
+	input := cloudformation.DescribeStackResourceDriftsInput{
+		StackName:                       aws.String(stackName),
+		StackResourceDriftStatusFilters: statuses,
+	}
+	var output []awstypes.StackResourceDrift
+
+	pages := cloudformation.NewDescribeStackResourceDriftsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.StackResourceDrifts...)
+	}
+
+	return output, nil
+}
+
+// stackResourceDriftDetail describes a drifted resource and its property differences,
+// e.g. "Resource Bucket (AWS::S3::Bucket) is MODIFIED: VersioningConfiguration.Status NOT_EQUAL (expected "Enabled", actual "Suspended")".
+func stackResourceDriftDetail(drift awstypes.StackResourceDrift) string {
+	detail := fmt.Sprintf("Resource %s (%s) is %s", aws.ToString(drift.LogicalResourceId), aws.ToString(drift.ResourceType), drift.StackResourceDriftStatus)
+
+	differences := make([]string, 0, len(drift.PropertyDifferences))
+	for _, v := range drift.PropertyDifferences {
+		differences = append(differences, fmt.Sprintf("%s %s (expected %q, actual %q)", aws.ToString(v.PropertyPath), v.DifferenceType, aws.ToString(v.ExpectedValue), aws.ToString(v.ActualValue)))
+	}
+
+	if len(differences) > 0 {
+		detail += ": " + strings.Join(differences, "; ")
+	}
+
+	return detail
+}
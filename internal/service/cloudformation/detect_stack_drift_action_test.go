@@ -0,0 +1,169 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudformation_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudFormationDetectStackDriftAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var stack awstypes.Stack
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudformation_stack.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFormationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetectStackDriftActionConfig_basic(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStackExists(ctx, resourceName, &stack),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudFormationDetectStackDriftAction_drifted(t *testing.T) {
+	ctx := acctest.Context(t)
+	var stack awstypes.Stack
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudformation_stack.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFormationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStackConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStackExists(ctx, resourceName, &stack),
+				),
+			},
+			{
+				PreConfig: func() { testAccDetectStackDriftActionDriftVPC(ctx, t, &stack) },
+				Config:    testAccDetectStackDriftActionConfig_basic(rName, false),
+			},
+			{
+				Config:      testAccDetectStackDriftActionConfig_basic(rName, true),
+				ExpectError: regexache.MustCompile(`(?s)Stack Drift Detected.*MyVPC \(AWS::EC2::VPC\) is MODIFIED`),
+			},
+		},
+	})
+}
+
+func TestAccCloudFormationDetectStackDriftAction_nonExistentStack(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFormationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDetectStackDriftActionConfig_nonExistentStack(rName),
+				ExpectError: regexache.MustCompile(`does not exist`),
+			},
+		},
+	})
+}
+
+// testAccDetectStackDriftActionDriftVPC changes the Name tag of the stack's VPC outside of CloudFormation.
+func testAccDetectStackDriftActionDriftVPC(ctx context.Context, t *testing.T, stack *awstypes.Stack) {
+	t.Helper()
+
+	var vpcID string
+	for _, v := range stack.Outputs {
+		if aws.ToString(v.OutputKey) == "VpcID" {
+			vpcID = aws.ToString(v.OutputValue)
+		}
+	}
+
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+	input := ec2.CreateTagsInput{
+		Resources: []string{vpcID},
+		Tags: []ec2types.Tag{{
+			Key:   aws.String("Name"),
+			Value: aws.String("Drifted_CF_VPC"),
+		}},
+	}
+
+	if _, err := conn.CreateTags(ctx, &input); err != nil {
+		t.Fatalf("tagging VPC (%s): %s", vpcID, err)
+	}
+}
+
+func testAccDetectStackDriftActionConfig_basic(rName string, failOnDrift bool) string {
+	return acctest.ConfigCompose(
+		testAccStackConfig_basic(rName),
+		fmt.Sprintf(`
+action "aws_cloudformation_detect_stack_drift" "test" {
+  config {
+    stack_name    = aws_cloudformation_stack.test.name
+    fail_on_drift = %[1]t
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = %[1]t
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_cloudformation_detect_stack_drift.test]
+    }
+  }
+
+  depends_on = [aws_cloudformation_stack.test]
+}
+`, failOnDrift))
+}
+
+func testAccDetectStackDriftActionConfig_nonExistentStack(rName string) string {
+	return fmt.Sprintf(`
+action "aws_cloudformation_detect_stack_drift" "test" {
+  config {
+    stack_name = %[1]q
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_cloudformation_detect_stack_drift.test]
+    }
+  }
+}
+`, rName)
+}
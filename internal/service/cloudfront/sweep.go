@@ -22,7 +22,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_cloudfront_cache_policy", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_cloudfront_cache_policy", &resource.Sweeper{
 		Name: "aws_cloudfront_cache_policy",
 		F:    sweepCachePolicies,
 		Dependencies: []string{
@@ -31,22 +31,22 @@ func RegisterSweepers() {
 	})
 
 	// DO NOT add a continuous deployment policy sweeper as these are swept as part of the distribution sweeper
-	// resource.AddTestSweepers("aws_cloudfront_continuous_deployment_policy", &resource.Sweeper{
+	// sweep.AddTestSweepers("aws_cloudfront_continuous_deployment_policy", &resource.Sweeper{
 	//	Name: "aws_cloudfront_continuous_deployment_policy",
 	//	F:    sweepContinuousDeploymentPolicies,
 	//})
 
-	resource.AddTestSweepers("aws_cloudfront_distribution", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_cloudfront_distribution", &resource.Sweeper{
 		Name: "aws_cloudfront_distribution",
 		F:    sweepDistributions,
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_field_level_encryption_config", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_cloudfront_field_level_encryption_config", &resource.Sweeper{
 		Name: "aws_cloudfront_field_level_encryption_config",
 		F:    sweepFieldLevelEncryptionConfigs,
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_field_level_encryption_profile", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_cloudfront_field_level_encryption_profile", &resource.Sweeper{
 		Name: "aws_cloudfront_field_level_encryption_profile",
 		F:    sweepFieldLevelEncryptionProfiles,
 		Dependencies: []string{
@@ -54,17 +54,17 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_function", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_cloudfront_function", &resource.Sweeper{
 		Name: "aws_cloudfront_function",
 		F:    sweepFunctions,
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_key_group", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_cloudfront_key_group", &resource.Sweeper{
 		Name: "aws_cloudfront_key_group",
 		F:    sweepKeyGroup,
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_monitoring_subscription", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_cloudfront_monitoring_subscription", &resource.Sweeper{
 		Name: "aws_cloudfront_monitoring_subscription",
 		F:    sweepMonitoringSubscriptions,
 		Dependencies: []string{
@@ -72,7 +72,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_origin_access_control", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_cloudfront_origin_access_control", &resource.Sweeper{
 		Name: "aws_cloudfront_origin_access_control",
 		F:    sweepOriginAccessControls,
 		Dependencies: []string{
@@ -80,7 +80,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_origin_request_policy", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_cloudfront_origin_request_policy", &resource.Sweeper{
 		Name: "aws_cloudfront_origin_request_policy",
 		F:    sweepOriginRequestPolicies,
 		Dependencies: []string{
@@ -88,12 +88,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_realtime_log_config", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_cloudfront_realtime_log_config", &resource.Sweeper{
 		Name: "aws_cloudfront_realtime_log_config",
 		F:    sweepRealtimeLogsConfig,
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_response_headers_policy", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_cloudfront_response_headers_policy", &resource.Sweeper{
 		Name: "aws_cloudfront_response_headers_policy",
 		F:    sweepResponseHeadersPolicies,
 		Dependencies: []string{
@@ -103,7 +103,7 @@ func RegisterSweepers() {
 
 	awsv2.Register("aws_cloudfront_trust_store", sweepTrustStores, "aws_cloudfront_distribution")
 
-	resource.AddTestSweepers("aws_cloudfront_vpc_origin", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_cloudfront_vpc_origin", &resource.Sweeper{
 		Name: "aws_cloudfront_vpc_origin",
 		F:    sweepVPCOrigins,
 	})
@@ -16,22 +16,22 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_cloudwatch_composite_alarm", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_cloudwatch_composite_alarm", &resource.Sweeper{
 		Name: "aws_cloudwatch_composite_alarm",
 		F:    sweepCompositeAlarms,
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_dashboard", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_cloudwatch_dashboard", &resource.Sweeper{
 		Name: "aws_cloudwatch_dashboard",
 		F:    sweepDashboards,
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_metric_alarm", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_cloudwatch_metric_alarm", &resource.Sweeper{
 		Name: "aws_cloudwatch_metric_alarm",
 		F:    sweepMetricAlarms,
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_metric_stream", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_cloudwatch_metric_stream", &resource.Sweeper{
 		Name: "aws_cloudwatch_metric_stream",
 		F:    sweepMetricStreams,
 	})
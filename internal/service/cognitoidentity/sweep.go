@@ -15,7 +15,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_cognito_identity_pool", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_cognito_identity_pool", &resource.Sweeper{
 		Name: "aws_cognito_identity_pool",
 		F:    sweepIdentityPools,
 	})
@@ -16,7 +16,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_cur_report_definition", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_cur_report_definition", &resource.Sweeper{
 		Name: "aws_cur_report_definition",
 		F:    sweepReportDefinitions,
 	})
@@ -9,11 +9,14 @@ import (
 	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func init() {
 	acctest.RegisterServiceErrorCheckFunc(names.EC2ServiceID, testAccErrorCheckSkip)
+	vcr.RegisterBodyNormalizer(names.EC2ServiceID, vcr.RemoveBodyFields("ClientToken"))
+	vcr.RegisterBodyNormalizer(names.EC2ServiceID, vcr.SortBodyLists("Filter", "Filter.Value"))
 }
 
 func testAccErrorCheckSkip(t *testing.T) resource.ErrorCheckFunc {
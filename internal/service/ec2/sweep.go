@@ -25,7 +25,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_customer_gateway", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_customer_gateway", &resource.Sweeper{
 		Name: "aws_customer_gateway",
 		F:    sweepCustomerGateways,
 		Dependencies: []string{
@@ -35,12 +35,12 @@ func RegisterSweepers() {
 
 	awsv2.Register("aws_ec2_capacity_reservation", sweepCapacityReservations)
 
-	resource.AddTestSweepers("aws_ec2_carrier_gateway", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_ec2_carrier_gateway", &resource.Sweeper{
 		Name: "aws_ec2_carrier_gateway",
 		F:    sweepCarrierGateways,
 	})
 
-	resource.AddTestSweepers("aws_ec2_client_vpn_endpoint", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_ec2_client_vpn_endpoint", &resource.Sweeper{
 		Name: "aws_ec2_client_vpn_endpoint",
 		F:    sweepClientVPNEndpoints,
 		Dependencies: []string{
@@ -48,17 +48,17 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_client_vpn_network_association", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_ec2_client_vpn_network_association", &resource.Sweeper{
 		Name: "aws_ec2_client_vpn_network_association",
 		F:    sweepClientVPNNetworkAssociations,
 	})
 
-	resource.AddTestSweepers("aws_ec2_fleet", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_ec2_fleet", &resource.Sweeper{
 		Name: "aws_ec2_fleet",
 		F:    sweepFleets,
 	})
 
-	resource.AddTestSweepers("aws_ebs_volume", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_ebs_volume", &resource.Sweeper{
 		Name: "aws_ebs_volume",
 		Dependencies: []string{
 			"aws_instance",
@@ -66,7 +66,7 @@ func RegisterSweepers() {
 		F: sweepEBSVolumes,
 	})
 
-	resource.AddTestSweepers("aws_ebs_snapshot", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_ebs_snapshot", &resource.Sweeper{
 		Name: "aws_ebs_snapshot",
 		F:    sweepEBSSnapshots,
 		Dependencies: []string{
@@ -74,12 +74,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_egress_only_internet_gateway", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_egress_only_internet_gateway", &resource.Sweeper{
 		Name: "aws_egress_only_internet_gateway",
 		F:    sweepEgressOnlyInternetGateways,
 	})
 
-	resource.AddTestSweepers("aws_eip", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_eip", &resource.Sweeper{
 		Name: "aws_eip",
 		Dependencies: []string{
 			"aws_eip_domain_name",
@@ -88,17 +88,17 @@ func RegisterSweepers() {
 		F: sweepEIPs,
 	})
 
-	resource.AddTestSweepers("aws_eip_domain_name", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_eip_domain_name", &resource.Sweeper{
 		Name: "aws_eip_domain_name",
 		F:    sweepEIPDomainNames,
 	})
 
-	resource.AddTestSweepers("aws_flow_log", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_flow_log", &resource.Sweeper{
 		Name: "aws_flow_log",
 		F:    sweepFlowLogs,
 	})
 
-	resource.AddTestSweepers("aws_ec2_host", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_ec2_host", &resource.Sweeper{
 		Name: "aws_ec2_host",
 		F:    sweepHosts,
 		Dependencies: []string{
@@ -106,7 +106,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_instance", &resource.Sweeper{
 		Name: "aws_instance",
 		F:    sweepInstances,
 		Dependencies: []string{
@@ -116,7 +116,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_internet_gateway", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_internet_gateway", &resource.Sweeper{
 		Name: "aws_internet_gateway",
 		Dependencies: []string{
 			"aws_subnet",
@@ -124,7 +124,7 @@ func RegisterSweepers() {
 		F: sweepInternetGateways,
 	})
 
-	resource.AddTestSweepers("aws_key_pair", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_key_pair", &resource.Sweeper{
 		Name: "aws_key_pair",
 		Dependencies: []string{
 			"aws_elastic_beanstalk_environment",
@@ -135,7 +135,7 @@ func RegisterSweepers() {
 		F: sweepKeyPairs,
 	})
 
-	resource.AddTestSweepers("aws_launch_template", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_launch_template", &resource.Sweeper{
 		Name: "aws_launch_template",
 		Dependencies: []string{
 			"aws_autoscaling_group",
@@ -144,17 +144,17 @@ func RegisterSweepers() {
 		F: sweepLaunchTemplates,
 	})
 
-	resource.AddTestSweepers("aws_nat_gateway", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_nat_gateway", &resource.Sweeper{
 		Name: "aws_nat_gateway",
 		F:    sweepNATGateways,
 	})
 
-	resource.AddTestSweepers("aws_network_acl", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_network_acl", &resource.Sweeper{
 		Name: "aws_network_acl",
 		F:    sweepNetworkACLs,
 	})
 
-	resource.AddTestSweepers("aws_network_interface", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_network_interface", &resource.Sweeper{
 		Name: "aws_network_interface",
 		F:    sweepNetworkInterfaces,
 		Dependencies: []string{
@@ -173,7 +173,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_managed_prefix_list", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_ec2_managed_prefix_list", &resource.Sweeper{
 		Name: "aws_ec2_managed_prefix_list",
 		F:    sweepManagedPrefixLists,
 		Dependencies: []string{
@@ -183,12 +183,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_network_insights_path", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_ec2_network_insights_path", &resource.Sweeper{
 		Name: "aws_ec2_network_insights_path",
 		F:    sweepNetworkInsightsPaths,
 	})
 
-	resource.AddTestSweepers("aws_placement_group", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_placement_group", &resource.Sweeper{
 		Name: "aws_placement_group",
 		F:    sweepPlacementGroups,
 		Dependencies: []string{
@@ -200,12 +200,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_route_table", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route_table", &resource.Sweeper{
 		Name: "aws_route_table",
 		F:    sweepRouteTables,
 	})
 
-	resource.AddTestSweepers("aws_security_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_security_group", &resource.Sweeper{
 		Name: "aws_security_group",
 		Dependencies: []string{
 			"aws_subnet",
@@ -213,12 +213,12 @@ func RegisterSweepers() {
 		F: sweepSecurityGroups,
 	})
 
-	resource.AddTestSweepers("aws_spot_fleet_request", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_spot_fleet_request", &resource.Sweeper{
 		Name: "aws_spot_fleet_request",
 		F:    sweepSpotFleetRequests,
 	})
 
-	resource.AddTestSweepers("aws_spot_instance_request", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_spot_instance_request", &resource.Sweeper{
 		Name: "aws_spot_instance_request",
 		F:    sweepSpotInstanceRequests,
 	})
@@ -273,7 +273,7 @@ func RegisterSweepers() {
 		"aws_vpc_endpoint",
 	)
 
-	resource.AddTestSweepers("aws_ec2_traffic_mirror_filter", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_ec2_traffic_mirror_filter", &resource.Sweeper{
 		Name: "aws_ec2_traffic_mirror_filter",
 		F:    sweepTrafficMirrorFilters,
 		Dependencies: []string{
@@ -281,12 +281,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_traffic_mirror_session", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_ec2_traffic_mirror_session", &resource.Sweeper{
 		Name: "aws_ec2_traffic_mirror_session",
 		F:    sweepTrafficMirrorSessions,
 	})
 
-	resource.AddTestSweepers("aws_ec2_traffic_mirror_target", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_ec2_traffic_mirror_target", &resource.Sweeper{
 		Name: "aws_ec2_traffic_mirror_target",
 		F:    sweepTrafficMirrorTargets,
 		Dependencies: []string{
@@ -294,17 +294,17 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway_peering_attachment", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_ec2_transit_gateway_peering_attachment", &resource.Sweeper{
 		Name: "aws_ec2_transit_gateway_peering_attachment",
 		F:    sweepTransitGatewayPeeringAttachments,
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway_multicast_domain", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_ec2_transit_gateway_multicast_domain", &resource.Sweeper{
 		Name: "aws_ec2_transit_gateway_multicast_domain",
 		F:    sweepTransitGatewayMulticastDomains,
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_ec2_transit_gateway", &resource.Sweeper{
 		Name: "aws_ec2_transit_gateway",
 		F:    sweepTransitGateways,
 		Dependencies: []string{
@@ -316,12 +316,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway_connect_peer", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_ec2_transit_gateway_connect_peer", &resource.Sweeper{
 		Name: "aws_ec2_transit_gateway_connect_peer",
 		F:    sweepTransitGatewayConnectPeers,
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway_connect", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_ec2_transit_gateway_connect", &resource.Sweeper{
 		Name: "aws_ec2_transit_gateway_connect",
 		F:    sweepTransitGatewayConnects,
 		Dependencies: []string{
@@ -330,7 +330,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway_vpc_attachment", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_ec2_transit_gateway_vpc_attachment", &resource.Sweeper{
 		Name: "aws_ec2_transit_gateway_vpc_attachment",
 		F:    sweepTransitGatewayVPCAttachments,
 		Dependencies: []string{
@@ -339,12 +339,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_vpc_dhcp_options", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_vpc_dhcp_options", &resource.Sweeper{
 		Name: "aws_vpc_dhcp_options",
 		F:    sweepVPCDHCPOptions,
 	})
 
-	resource.AddTestSweepers("aws_vpc_endpoint", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_vpc_endpoint", &resource.Sweeper{
 		Name: "aws_vpc_endpoint",
 		F:    sweepVPCEndpoints,
 		Dependencies: []string{
@@ -354,12 +354,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_vpc_endpoint_connection_accepter", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_vpc_endpoint_connection_accepter", &resource.Sweeper{
 		Name: "aws_vpc_endpoint_connection_accepter",
 		F:    sweepVPCEndpointConnectionAccepters,
 	})
 
-	resource.AddTestSweepers("aws_vpc_endpoint_service", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_vpc_endpoint_service", &resource.Sweeper{
 		Name: "aws_vpc_endpoint_service",
 		F:    sweepVPCEndpointServices,
 		Dependencies: []string{
@@ -367,12 +367,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_vpc_peering_connection", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_vpc_peering_connection", &resource.Sweeper{
 		Name: "aws_vpc_peering_connection",
 		F:    sweepVPCPeeringConnections,
 	})
 
-	resource.AddTestSweepers("aws_vpc", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_vpc", &resource.Sweeper{
 		Name: "aws_vpc",
 		Dependencies: []string{
 			"aws_ec2_carrier_gateway",
@@ -395,12 +395,12 @@ func RegisterSweepers() {
 
 	awsv2.Register("aws_vpn_concentrator", sweepVPNConcentrators, "aws_vpn_connection")
 
-	resource.AddTestSweepers("aws_vpn_connection", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_vpn_connection", &resource.Sweeper{
 		Name: "aws_vpn_connection",
 		F:    sweepVPNConnections,
 	})
 
-	resource.AddTestSweepers("aws_vpn_gateway", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_vpn_gateway", &resource.Sweeper{
 		Name: "aws_vpn_gateway",
 		F:    sweepVPNGateways,
 		Dependencies: []string{
@@ -413,22 +413,22 @@ func RegisterSweepers() {
 	awsv2.Register("aws_vpc_ipam", sweepIPAMs)
 	awsv2.Register("aws_vpc_ipam_resource_discovery", sweepIPAMResourceDiscoveries)
 
-	resource.AddTestSweepers("aws_ami", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_ami", &resource.Sweeper{
 		Name: "aws_ami",
 		F:    sweepAMIs,
 	})
 
-	resource.AddTestSweepers("aws_vpc_network_performance_metric_subscription", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_vpc_network_performance_metric_subscription", &resource.Sweeper{
 		Name: "aws_vpc_network_performance_metric_subscription",
 		F:    sweepNetworkPerformanceMetricSubscriptions,
 	})
 
-	resource.AddTestSweepers("aws_ec2_instance_connect_endpoint", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_ec2_instance_connect_endpoint", &resource.Sweeper{
 		Name: "aws_ec2_instance_connect_endpoint",
 		F:    sweepInstanceConnectEndpoints,
 	})
 
-	resource.AddTestSweepers("aws_verifiedaccess_trust_provider", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_verifiedaccess_trust_provider", &resource.Sweeper{
 		Name: "aws_verifiedaccess_trust_provider",
 		F:    sweepVerifiedAccessTrustProviders,
 		Dependencies: []string{
@@ -436,7 +436,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_verifiedaccess_instance_trust_provider_attachment", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_verifiedaccess_instance_trust_provider_attachment", &resource.Sweeper{
 		Name: "aws_verifiedaccess_instance_trust_provider_attachment",
 		F:    sweepVerifiedAccessTrustProviderAttachments,
 		Dependencies: []string{
@@ -444,7 +444,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_verifiedaccess_group", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_verifiedaccess_group", &resource.Sweeper{
 		Name: "aws_verifiedaccess_group",
 		F:    sweepVerifiedAccessGroups,
 		Dependencies: []string{
@@ -452,12 +452,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_verifiedaccess_endpoint", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_verifiedaccess_endpoint", &resource.Sweeper{
 		Name: "aws_verifiedaccess_endpoint",
 		F:    sweepVerifiedAccessEndpoints,
 	})
 
-	resource.AddTestSweepers("aws_verifiedaccess_instance", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_verifiedaccess_instance", &resource.Sweeper{
 		Name: "aws_verifiedaccess_instance",
 		F:    sweepVerifiedAccessInstances,
 		Dependencies: []string{
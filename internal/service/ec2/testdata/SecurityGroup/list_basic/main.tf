@@ -0,0 +1,27 @@
+# Copyright IBM Corp. 2014, 2025
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = var.rName
+  }
+}
+
+resource "aws_security_group" "test" {
+  count = 2
+
+  name   = "${var.rName}-${count.index}"
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = "${var.rName}-${count.index}"
+  }
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
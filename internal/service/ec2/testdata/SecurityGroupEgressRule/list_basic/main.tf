@@ -0,0 +1,47 @@
+# Copyright IBM Corp. 2014, 2025
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = var.rName
+  }
+}
+
+resource "aws_security_group" "test" {
+  name   = var.rName
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = var.rName
+  }
+}
+
+resource "aws_vpc_security_group_ingress_rule" "test" {
+  count = 2
+
+  security_group_id = aws_security_group.test.id
+
+  cidr_ipv4   = "10.0.0.0/8"
+  from_port   = 80 + count.index
+  ip_protocol = "tcp"
+  to_port     = 80 + count.index
+}
+
+resource "aws_vpc_security_group_egress_rule" "test" {
+  count = 2
+
+  security_group_id = aws_security_group.test.id
+
+  cidr_ipv4   = "10.0.0.0/8"
+  from_port   = 443 + count.index
+  ip_protocol = "tcp"
+  to_port     = 443 + count.index
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
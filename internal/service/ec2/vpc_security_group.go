@@ -18,6 +18,9 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
@@ -25,13 +28,20 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/logging"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/aws/aws-sdk-go-v2/otelaws"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // @SDKResource("aws_security_group", name="Security Group")
@@ -116,6 +126,14 @@ func resourceSecurityGroup() *schema.Resource {
 	}
 }
 
+// @SDKListResource("aws_security_group")
+func securityGroupResourceAsListResource() inttypes.ListResourceForSDK {
+	l := securityGroupListResource{}
+	l.SetResourceSchema(resourceSecurityGroup())
+
+	return &l
+}
+
 // Security Group rule nested block definition.
 // Used in aws_security_group and aws_default_security_group ingress and egress rule sets.
 var (
@@ -280,6 +298,14 @@ func resourceSecurityGroupRead(ctx context.Context, d *schema.ResourceData, meta
 		return sdkdiag.AppendErrorf(diags, "reading Security Group (%s): %s", d.Id(), err)
 	}
 
+	if err := resourceSecurityGroupFlatten(ctx, c, sg, d); err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Security Group (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceSecurityGroupFlatten(ctx context.Context, c *conns.AWSClient, sg *awstypes.SecurityGroup, d *schema.ResourceData) error {
 	remoteIngressRules := securityGroupIPPermGather(d.Id(), sg.IpPermissions, sg.OwnerId)
 	remoteEgressRules := securityGroupIPPermGather(d.Id(), sg.IpPermissionsEgress, sg.OwnerId)
 
@@ -300,16 +326,16 @@ func resourceSecurityGroupRead(ctx context.Context, d *schema.ResourceData, meta
 	d.Set(names.AttrVPCID, sg.VpcId)
 
 	if err := d.Set("ingress", ingressRules); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting ingress: %s", err)
+		return fmt.Errorf("setting ingress: %w", err)
 	}
 
 	if err := d.Set("egress", egressRules); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting egress: %s", err)
+		return fmt.Errorf("setting egress: %w", err)
 	}
 
 	setTagsOut(ctx, sg.Tags)
 
-	return diags
+	return nil
 }
 
 func resourceSecurityGroupUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -1532,3 +1558,133 @@ func initSecurityGroupRule(ruleMap map[string]map[string]any, perm awstypes.IpPe
 
 	return rule
 }
+
+var _ list.ListResourceWithRawV5Schemas = &securityGroupListResource{}
+
+type securityGroupListResource struct {
+	framework.ResourceWithConfigure
+	framework.ListResourceWithSDKv2Resource
+	framework.ListResourceWithSDKv2Tags
+}
+
+type securityGroupListResourceModel struct {
+	framework.WithRegionModel
+	GroupIDs fwtypes.ListValueOf[types.String] `tfsdk:"security_group_ids"`
+	Filters  customListFilters                 `tfsdk:"filter"`
+}
+
+func (l *securityGroupListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			names.AttrSecurityGroupIDs: listschema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]listschema.Block{
+			names.AttrFilter: listschema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[customListFilterModel](ctx),
+				NestedObject: listschema.NestedBlockObject{
+					Attributes: map[string]listschema.Attribute{
+						names.AttrName: listschema.StringAttribute{
+							Required: true,
+						},
+						names.AttrValues: listschema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (l *securityGroupListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.EC2Client(ctx)
+
+	attributes := []attribute.KeyValue{
+		otelaws.RegionAttr(awsClient.Region(ctx)),
+	}
+	for _, attribute := range attributes {
+		ctx = tflog.SetField(ctx, string(attribute.Key), attribute.Value.AsInterface())
+	}
+
+	var query securityGroupListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	var input ec2.DescribeSecurityGroupsInput
+	if diags := fwflex.Expand(ctx, query, &input); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.Info(ctx, "Listing resources")
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		pages := ec2.NewDescribeSecurityGroupsPaginator(conn, &input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			for _, sg := range page.SecurityGroups {
+				// Default Security Groups are managed by aws_default_security_group.
+				if aws.ToString(sg.GroupName) == defaultSecurityGroupName {
+					continue
+				}
+
+				ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), aws.ToString(sg.GroupId))
+
+				result := request.NewListResult(ctx)
+
+				tags := keyValueTags(ctx, sg.Tags)
+
+				rd := l.ResourceData()
+				rd.SetId(aws.ToString(sg.GroupId))
+
+				tflog.Info(ctx, "Reading resource")
+				if err := resourceSecurityGroupFlatten(ctx, awsClient, &sg, rd); err != nil {
+					result = fwdiag.NewListResultErrorDiagnostic(err)
+					yield(result)
+					return
+				}
+
+				// set tags
+				err = l.SetTags(ctx, awsClient, rd)
+				if err != nil {
+					result = fwdiag.NewListResultErrorDiagnostic(err)
+					yield(result)
+					return
+				}
+
+				if v, ok := tags["Name"]; ok {
+					result.DisplayName = fmt.Sprintf("%s (%s)", v.ValueString(), aws.ToString(sg.GroupId))
+				} else {
+					result.DisplayName = fmt.Sprintf("%s (%s)", aws.ToString(sg.GroupName), aws.ToString(sg.GroupId))
+				}
+
+				l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+				if result.Diagnostics.HasError() {
+					yield(result)
+					return
+				}
+
+				if !yield(result) {
+					return
+				}
+			}
+		}
+	}
+}
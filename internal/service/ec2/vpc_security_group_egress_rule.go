@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 )
@@ -26,6 +27,13 @@ func newSecurityGroupEgressRuleResource(context.Context) (resource.ResourceWithC
 	return r, nil
 }
 
+// @FrameworkListResource("aws_vpc_security_group_egress_rule")
+func securityGroupEgressRuleResourceAsListResource() list.ListResourceWithConfigure {
+	return &securityGroupRuleListResource{
+		isEgress: true,
+	}
+}
+
 type securityGroupEgressRuleResource struct {
 	securityGroupRuleResource
 }
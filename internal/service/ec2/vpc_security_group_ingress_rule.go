@@ -17,6 +17,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -36,6 +38,8 @@ import (
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/listresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -54,6 +58,11 @@ func newSecurityGroupIngressRuleResource(context.Context) (resource.ResourceWith
 	return r, nil
 }
 
+// @FrameworkListResource("aws_vpc_security_group_ingress_rule")
+func securityGroupIngressRuleResourceAsListResource() list.ListResourceWithConfigure {
+	return &securityGroupRuleListResource{}
+}
+
 type securityGroupIngressRuleResource struct {
 	securityGroupRuleResource
 }
@@ -291,26 +300,7 @@ func (r *securityGroupRuleResource) Read(ctx context.Context, request resource.R
 	}
 
 	data.ARN = r.securityGroupRuleARN(ctx, data.ID.ValueString())
-	data.CIDRIPv4 = fwflex.StringToFramework(ctx, output.CidrIpv4)
-	data.CIDRIPv6 = fwflex.StringToFramework(ctx, output.CidrIpv6)
-	data.Description = fwflex.StringToFramework(ctx, output.Description)
-	data.IPProtocol = fwflex.StringToFrameworkValuable[ipProtocol](ctx, output.IpProtocol)
-	data.PrefixListID = fwflex.StringToFramework(ctx, output.PrefixListId)
-	data.ReferencedSecurityGroupID = flattenReferencedSecurityGroup(ctx, output.ReferencedGroupInfo, r.Meta().AccountID(ctx))
-	data.SecurityGroupID = fwflex.StringToFramework(ctx, output.GroupId)
-	data.SecurityGroupRuleID = fwflex.StringToFramework(ctx, output.SecurityGroupRuleId)
-
-	// If planned from_port or to_port are null and values of -1 are returned, propagate null.
-	if v := aws.ToInt32(output.FromPort); v == -1 && data.FromPort.IsNull() {
-		data.FromPort = types.Int64Null()
-	} else {
-		data.FromPort = fwflex.Int32ToFrameworkInt64(ctx, output.FromPort)
-	}
-	if v := aws.ToInt32(output.ToPort); v == -1 && data.ToPort.IsNull() {
-		data.ToPort = types.Int64Null()
-	} else {
-		data.ToPort = fwflex.Int32ToFrameworkInt64(ctx, output.ToPort)
-	}
+	data.flatten(ctx, output, r.Meta().AccountID(ctx))
 
 	setTagsOut(ctx, output.Tags)
 
@@ -456,6 +446,29 @@ func (model *securityGroupRuleResourceModel) setID() {
 	model.ID = model.SecurityGroupRuleID
 }
 
+func (model *securityGroupRuleResourceModel) flatten(ctx context.Context, apiObject *awstypes.SecurityGroupRule, accountID string) {
+	model.CIDRIPv4 = fwflex.StringToFramework(ctx, apiObject.CidrIpv4)
+	model.CIDRIPv6 = fwflex.StringToFramework(ctx, apiObject.CidrIpv6)
+	model.Description = fwflex.StringToFramework(ctx, apiObject.Description)
+	model.IPProtocol = fwflex.StringToFrameworkValuable[ipProtocol](ctx, apiObject.IpProtocol)
+	model.PrefixListID = fwflex.StringToFramework(ctx, apiObject.PrefixListId)
+	model.ReferencedSecurityGroupID = flattenReferencedSecurityGroup(ctx, apiObject.ReferencedGroupInfo, accountID)
+	model.SecurityGroupID = fwflex.StringToFramework(ctx, apiObject.GroupId)
+	model.SecurityGroupRuleID = fwflex.StringToFramework(ctx, apiObject.SecurityGroupRuleId)
+
+	// If planned from_port or to_port are null and values of -1 are returned, propagate null.
+	if v := aws.ToInt32(apiObject.FromPort); v == -1 && model.FromPort.IsNull() {
+		model.FromPort = types.Int64Null()
+	} else {
+		model.FromPort = fwflex.Int32ToFrameworkInt64(ctx, apiObject.FromPort)
+	}
+	if v := aws.ToInt32(apiObject.ToPort); v == -1 && model.ToPort.IsNull() {
+		model.ToPort = types.Int64Null()
+	} else {
+		model.ToPort = fwflex.Int32ToFrameworkInt64(ctx, apiObject.ToPort)
+	}
+}
+
 func (model *securityGroupRuleResourceModel) expandIPPermission(ctx context.Context) awstypes.IpPermission {
 	apiObject := awstypes.IpPermission{
 		FromPort:   fwflex.Int32FromFrameworkInt64(ctx, model.FromPort),
@@ -531,6 +544,149 @@ func (model *securityGroupRuleResourceModel) sourceAttributeName() string {
 	return ""
 }
 
+// Base structure and methods for VPC security group rule list resources.
+
+var _ list.ListResource = &securityGroupRuleListResource{}
+
+type securityGroupRuleListResource struct {
+	framework.ResourceWithModel[securityGroupRuleResourceModel]
+	framework.WithList
+	isEgress bool
+}
+
+type securityGroupRuleListResourceModel struct {
+	framework.WithRegionModel
+	Filters         customListFilters `tfsdk:"filter"`
+	SecurityGroupID types.String      `tfsdk:"security_group_id"`
+}
+
+func (l *securityGroupRuleListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"security_group_id": listschema.StringAttribute{
+				Optional: true,
+			},
+		},
+		Blocks: map[string]listschema.Block{
+			names.AttrFilter: listschema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[customListFilterModel](ctx),
+				NestedObject: listschema.NestedBlockObject{
+					Attributes: map[string]listschema.Attribute{
+						names.AttrName: listschema.StringAttribute{
+							Required: true,
+						},
+						names.AttrValues: listschema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (l *securityGroupRuleListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var query securityGroupRuleListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	awsClient := l.Meta()
+	conn := awsClient.EC2Client(ctx)
+
+	var input ec2.DescribeSecurityGroupRulesInput
+	if diags := fwflex.Expand(ctx, query, &input); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	if v := query.SecurityGroupID.ValueString(); v != "" {
+		input.Filters = append(input.Filters, awstypes.Filter{
+			Name:   aws.String("group-id"),
+			Values: []string{v},
+		})
+	}
+
+	tflog.Info(ctx, "Listing resources")
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		pages := ec2.NewDescribeSecurityGroupRulesPaginator(conn, &input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			for _, rule := range page.SecurityGroupRules {
+				if aws.ToBool(rule.IsEgress) != l.isEgress {
+					continue
+				}
+
+				id := aws.ToString(rule.SecurityGroupRuleId)
+				ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), id)
+				ctx = tftags.NewContext(ctx, awsClient.DefaultTagsConfig(ctx), awsClient.IgnoreTagsConfig(ctx), awsClient.TagPolicyConfig(ctx))
+
+				result := request.NewListResult(ctx)
+
+				var data securityGroupRuleResourceModel
+				data.Tags.MapValue = l.ListResourceTagsInit(ctx, result)
+				data.TagsAll.MapValue = l.ListResourceTagsInit(ctx, result)
+
+				params := listresource.InterceptorParams{
+					C:      awsClient,
+					Result: &result,
+				}
+
+				if diags := l.RunResultInterceptors(ctx, listresource.Before, params); diags.HasError() {
+					result.Diagnostics.Append(diags...)
+					yield(result)
+					return
+				}
+
+				tflog.Info(ctx, "Reading resource")
+				data.ARN = l.securityGroupRuleARN(ctx, id)
+				data.flatten(ctx, &rule, awsClient.AccountID(ctx))
+				data.setID()
+
+				setTagsOut(ctx, rule.Tags)
+
+				if diags := result.Resource.Set(ctx, &data); diags.HasError() {
+					result.Diagnostics.Append(diags...)
+					yield(result)
+					return
+				}
+
+				if v, ok := keyValueTags(ctx, rule.Tags)["Name"]; ok {
+					result.DisplayName = fmt.Sprintf("%s (%s)", v.ValueString(), id)
+				} else {
+					result.DisplayName = fmt.Sprintf("%s (%s)", id, aws.ToString(rule.GroupId))
+				}
+
+				if diags := l.RunResultInterceptors(ctx, listresource.After, params); diags.HasError() {
+					result.Diagnostics.Append(diags...)
+					yield(result)
+					return
+				}
+
+				if !yield(result) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func (l *securityGroupRuleListResource) securityGroupRuleARN(ctx context.Context, id string) types.String {
+	return types.StringValue(l.Meta().RegionalARN(ctx, names.EC2, fmt.Sprintf("security-group-rule/%s", id)))
+}
+
 var (
 	_ basetypes.StringTypable                    = (*ipProtocolType)(nil)
 	_ basetypes.StringValuableWithSemanticEquals = (*ipProtocol)(nil)
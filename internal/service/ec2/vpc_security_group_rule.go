@@ -164,7 +164,9 @@ func resourceSecurityGroupRuleCreate(ctx context.Context, d *schema.ResourceData
 	conn := meta.(*conns.AWSClient).EC2Client(ctx)
 	securityGroupID := d.Get("security_group_id").(string)
 
-	conns.GlobalMutexKV.Lock(securityGroupID)
+	if err := conns.GlobalMutexKV.LockContext(ctx, securityGroupID); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
 	defer conns.GlobalMutexKV.Unlock(securityGroupID)
 
 	sg, err := findSecurityGroupByID(ctx, conn, securityGroupID)
@@ -344,7 +346,9 @@ func resourceSecurityGroupRuleUpdate(ctx context.Context, d *schema.ResourceData
 	if d.HasChange(names.AttrDescription) {
 		securityGroupID := d.Get("security_group_id").(string)
 
-		conns.GlobalMutexKV.Lock(securityGroupID)
+		if err := conns.GlobalMutexKV.LockContext(ctx, securityGroupID); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
 		defer conns.GlobalMutexKV.Unlock(securityGroupID)
 
 		sg, err := findSecurityGroupByID(ctx, conn, securityGroupID)
@@ -388,7 +392,9 @@ func resourceSecurityGroupRuleDelete(ctx context.Context, d *schema.ResourceData
 	conn := meta.(*conns.AWSClient).EC2Client(ctx)
 	securityGroupID := d.Get("security_group_id").(string)
 
-	conns.GlobalMutexKV.Lock(securityGroupID)
+	if err := conns.GlobalMutexKV.LockContext(ctx, securityGroupID); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
 	defer conns.GlobalMutexKV.Unlock(securityGroupID)
 
 	sg, err := findSecurityGroupByID(ctx, conn, securityGroupID)
@@ -17,7 +17,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_ecrpublic_repository", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_ecrpublic_repository", &resource.Sweeper{
 		Name: "aws_ecrpublic_repository",
 		F:    sweepRepositories,
 	})
@@ -12,23 +12,35 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/ecs"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2/importer"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // @SDKResource("aws_ecs_cluster", name="Cluster")
 // @Tags(identifierAttribute="arn")
+// @ArnIdentity
+// @CustomImport
 // @ArnFormat("cluster/{name}")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ecs/types;awstypes;awstypes.Cluster")
+// @Testing(preIdentityVersion="v6.26.0")
 func resourceCluster() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceClusterCreate,
@@ -163,6 +175,95 @@ func resourceCluster() *schema.Resource {
 	}
 }
 
+// @SDKListResource("aws_ecs_cluster")
+func clusterResourceAsListResource() inttypes.ListResourceForSDK {
+	l := clusterListResource{}
+	l.SetResourceSchema(resourceCluster())
+
+	return &l
+}
+
+type clusterListResource struct {
+	framework.ResourceWithConfigure
+	framework.ListResourceWithSDKv2Resource
+	framework.ListResourceWithSDKv2Tags
+}
+
+type clusterListResourceModel struct {
+	framework.WithRegionModel
+}
+
+func (l *clusterListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{},
+		Blocks:     map[string]listschema.Block{},
+	}
+}
+
+func (l *clusterListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var query clusterListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	awsClient := l.Meta()
+	conn := awsClient.ECSClient(ctx)
+
+	tflog.Info(ctx, "Listing ECS clusters")
+	stream.Results = func(yield func(list.ListResult) bool) {
+		var input ecs.ListClustersInput
+		err := listClustersPages(ctx, conn, &input, func(page *ecs.ListClustersOutput, lastPage bool) bool {
+			for _, clusterARN := range page.ClusterArns {
+				ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), clusterARN)
+
+				result := request.NewListResult(ctx)
+
+				rd := l.ResourceData()
+				rd.SetId(clusterARN)
+
+				diags := resourceClusterRead(ctx, rd, awsClient)
+				if diags.HasError() || rd.Id() == "" {
+					// Resource can't be read or is logically deleted.
+					// Log and continue.
+					tflog.Error(ctx, "Reading ECS cluster", map[string]any{
+						names.AttrID: clusterARN,
+						"diags":      sdkdiag.DiagnosticsString(diags),
+					})
+					continue
+				}
+
+				if err := l.SetTags(ctx, awsClient, rd); err != nil {
+					result = fwdiag.NewListResultErrorDiagnostic(err)
+					yield(result)
+					return false
+				}
+
+				result.DisplayName = rd.Get(names.AttrName).(string)
+
+				l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+				if result.Diagnostics.HasError() {
+					yield(result)
+					return false
+				}
+
+				if !yield(result) {
+					return false
+				}
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			result := fwdiag.NewListResultErrorDiagnostic(err)
+			yield(result)
+		}
+	}
+}
+
 func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ECSClient(ctx)
@@ -329,6 +430,14 @@ func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta any
 }
 
 func resourceClusterImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	if d.Id() == "" || arn.IsARN(d.Id()) {
+		if err := importer.RegionalARN(ctx, d, importer.IdentitySpec(ctx)); err != nil {
+			return nil, err
+		}
+
+		return []*schema.ResourceData{d}, nil
+	}
+
 	d.Set(names.AttrName, d.Id())
 
 	region := d.Get(names.AttrRegion).(string)
@@ -0,0 +1,241 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// forceNewDeploymentPollInterval defines polling cadence for the force new deployment action.
+const forceNewDeploymentPollInterval = 15 * time.Second
+
+// @Action(aws_ecs_force_new_deployment, name="Force New Deployment")
+func newForceNewDeploymentAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &forceNewDeploymentAction{}, nil
+}
+
+var (
+	_ action.Action = (*forceNewDeploymentAction)(nil)
+)
+
+type forceNewDeploymentAction struct {
+	framework.ActionWithModel[forceNewDeploymentModel]
+}
+
+type forceNewDeploymentModel struct {
+	framework.WithRegionModel
+	Cluster types.String `tfsdk:"cluster"`
+	Service types.String `tfsdk:"service"`
+	Timeout types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *forceNewDeploymentAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Forces a new deployment of an ECS service and waits for the deployment to complete.",
+		Attributes: map[string]schema.Attribute{
+			"cluster": schema.StringAttribute{
+				Description: "Name or ARN of the ECS cluster that hosts the service",
+				Required:    true,
+			},
+			"service": schema.StringAttribute{
+				Description: "Name or ARN of the ECS service to redeploy",
+				Required:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the deployment to complete (default: 1800)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(7200),
+				},
+			},
+		},
+	}
+}
+
+func (a *forceNewDeploymentAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config forceNewDeploymentModel
+
+	// Parse configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get AWS client
+	conn := a.Meta().ECSClient(ctx)
+
+	cluster := config.Cluster.ValueString()
+	service := config.Service.ValueString()
+
+	// Set default timeout if not provided
+	timeout := 1800 * time.Second
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting ECS force new deployment action", map[string]any{
+		"cluster":         cluster,
+		"service":         service,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Forcing new deployment of ECS service %s in cluster %s...", service, cluster),
+	})
+
+	input := ecs.UpdateServiceInput{
+		Cluster:            aws.String(cluster),
+		ForceNewDeployment: true,
+		Service:            aws.String(service),
+	}
+
+	output, err := conn.UpdateService(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Force New Deployment",
+			fmt.Sprintf("Could not force new deployment of ECS service %s in cluster %s: %s", service, cluster, err),
+		)
+		return
+	}
+
+	primary := findPrimaryTaskSet(output.Service.Deployments)
+	if primary == nil {
+		resp.Diagnostics.AddError(
+			"Failed to Force New Deployment",
+			fmt.Sprintf("ECS service %s in cluster %s has no primary deployment after forcing a new deployment", service, cluster),
+		)
+		return
+	}
+
+	deploymentID := aws.ToString(primary.Id)
+	rollback := output.Service.DeploymentConfiguration != nil && output.Service.DeploymentConfiguration.DeploymentCircuitBreaker != nil && output.Service.DeploymentConfiguration.DeploymentCircuitBreaker.Rollback
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Deployment %s started for ECS service %s, waiting for it to complete...", deploymentID, service),
+	})
+
+	// Follow the deployment's rollout state rather than the service's overall stability
+	// so that a circuit breaker rollback is reported as a failure of this deployment.
+	fr, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.Deployment], error) {
+		output, err := findServiceNoTagsByTwoPartKey(ctx, conn, service, cluster)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.Deployment]{}, fmt.Errorf("describing service: %w", err)
+		}
+
+		deployment := findDeploymentByID(output.Deployments, deploymentID)
+		if deployment == nil {
+			return actionwait.FetchResult[*awstypes.Deployment]{}, fmt.Errorf("deployment %s no longer present on service", deploymentID)
+		}
+
+		return actionwait.FetchResult[*awstypes.Deployment]{Status: actionwait.Status(deploymentRolloutState(output, deployment)), Value: deployment}, nil
+	}, actionwait.Options[*awstypes.Deployment]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(forceNewDeploymentPollInterval),
+		ProgressInterval: 30 * time.Second,
+		SuccessStates:    []actionwait.Status{actionwait.Status(awstypes.DeploymentRolloutStateCompleted)},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.DeploymentRolloutStateInProgress),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.DeploymentRolloutStateFailed),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			if deployment, ok := fr.Value.(*awstypes.Deployment); ok && deployment != nil {
+				resp.SendProgress(action.InvokeProgressEvent{
+					Message: fmt.Sprintf("Deployment %s of ECS service %s is %s: %d running, %d pending, %d desired, %d failed tasks", deploymentID, service, fr.Status, deployment.RunningCount, deployment.PendingCount, deployment.DesiredCount, deployment.FailedTasks),
+				})
+			}
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Deployment",
+				fmt.Sprintf("Deployment %s of ECS service %s did not complete within %s: %s", deploymentID, service, timeout, err),
+			)
+		} else if errors.As(err, &failureErr) {
+			var reason string
+			if fr.Value != nil {
+				reason = aws.ToString(fr.Value.RolloutStateReason)
+			}
+			if rollback {
+				resp.Diagnostics.AddError(
+					"Deployment Rolled Back",
+					fmt.Sprintf("Deployment %s of ECS service %s failed and was rolled back by the deployment circuit breaker: %s", deploymentID, service, reason),
+				)
+			} else {
+				resp.Diagnostics.AddError(
+					"Deployment Failed",
+					fmt.Sprintf("Deployment %s of ECS service %s failed: %s", deploymentID, service, reason),
+				)
+			}
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected Deployment State",
+				fmt.Sprintf("Deployment %s of ECS service %s entered unexpected state: %s", deploymentID, service, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Deployment",
+				fmt.Sprintf("Error while waiting for deployment %s of ECS service %s: %s", deploymentID, service, err),
+			)
+		}
+		return
+	}
+
+	// Final success message
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Deployment %s of ECS service %s completed successfully with %d running tasks", deploymentID, service, fr.Value.RunningCount),
+	})
+
+	tflog.Info(ctx, "ECS force new deployment action completed successfully", map[string]any{
+		"cluster":       cluster,
+		"service":       service,
+		"deployment_id": deploymentID,
+	})
+}
+
+func findDeploymentByID(deployments []awstypes.Deployment, id string) *awstypes.Deployment {
+	for _, deployment := range deployments {
+		if aws.ToString(deployment.Id) == id {
+			return &deployment
+		}
+	}
+	return nil
+}
+
+// deploymentRolloutState returns the rollout state of the deployment.
+// The rollout state isn't returned for services that aren't eligible for the deployment circuit breaker,
+// in which case the deployment is considered complete once it is the only deployment and all its tasks are running.
+func deploymentRolloutState(service *awstypes.Service, deployment *awstypes.Deployment) awstypes.DeploymentRolloutState {
+	if deployment.RolloutState != "" {
+		return deployment.RolloutState
+	}
+
+	if len(service.Deployments) == 1 && deployment.RunningCount == deployment.DesiredCount {
+		return awstypes.DeploymentRolloutStateCompleted
+	}
+
+	return awstypes.DeploymentRolloutStateInProgress
+}
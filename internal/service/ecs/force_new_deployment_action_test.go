@@ -0,0 +1,202 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfecs "github.com/hashicorp/terraform-provider-aws/internal/service/ecs"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccECSForceNewDeploymentAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var service awstypes.Service
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_ecs_service.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ECS)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccForceNewDeploymentActionConfig_base(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(ctx, resourceName, &service),
+				),
+			},
+			{
+				Config: testAccForceNewDeploymentActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckForceNewDeploymentActionDeployed(ctx, resourceName, &service),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECSForceNewDeploymentAction_nonExistentService(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ECS)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccForceNewDeploymentActionConfig_nonExistentService(rName, rName+"-missing"),
+				ExpectError: regexache.MustCompile(`ServiceNotFoundException`),
+			},
+		},
+	})
+}
+
+// testAccCheckForceNewDeploymentActionDeployed verifies that the service's primary deployment
+// differs from the one recorded before the action was triggered and has completed.
+func testAccCheckForceNewDeploymentActionDeployed(ctx context.Context, n string, before *awstypes.Service) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ECSClient(ctx)
+
+		after, err := tfecs.FindServiceNoTagsByTwoPartKey(ctx, conn, rs.Primary.ID, rs.Primary.Attributes["cluster"])
+		if err != nil {
+			return err
+		}
+
+		beforePrimary, afterPrimary := testAccPrimaryDeployment(before), testAccPrimaryDeployment(after)
+		if afterPrimary == nil {
+			return fmt.Errorf("ECS Service (%s) has no primary deployment", rs.Primary.ID)
+		}
+
+		if beforePrimary != nil && aws.ToString(beforePrimary.Id) == aws.ToString(afterPrimary.Id) {
+			return fmt.Errorf("ECS Service (%s) primary deployment %s was not replaced", rs.Primary.ID, aws.ToString(afterPrimary.Id))
+		}
+
+		if state := afterPrimary.RolloutState; state != "" && state != awstypes.DeploymentRolloutStateCompleted {
+			return fmt.Errorf("ECS Service (%s) deployment %s rollout state is %s, expected %s", rs.Primary.ID, aws.ToString(afterPrimary.Id), state, awstypes.DeploymentRolloutStateCompleted)
+		}
+
+		return nil
+	}
+}
+
+func testAccPrimaryDeployment(service *awstypes.Service) *awstypes.Deployment {
+	for _, deployment := range service.Deployments {
+		if aws.ToString(deployment.Status) == "PRIMARY" {
+			return &deployment
+		}
+	}
+	return nil
+}
+
+func testAccForceNewDeploymentActionConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecs_task_definition" "test" {
+  family = %[1]q
+
+  container_definitions = <<DEFINITION
+[
+  {
+    "cpu": 128,
+    "essential": true,
+    "image": "mongo:latest",
+    "memory": 128,
+    "name": "mongodb"
+  }
+]
+DEFINITION
+}
+
+resource "aws_ecs_service" "test" {
+  name            = %[1]q
+  cluster         = aws_ecs_cluster.test.id
+  task_definition = aws_ecs_task_definition.test.arn
+  desired_count   = 0
+}
+`, rName)
+}
+
+func testAccForceNewDeploymentActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccForceNewDeploymentActionConfig_base(rName),
+		`
+action "aws_ecs_force_new_deployment" "test" {
+  config {
+    cluster = aws_ecs_cluster.test.name
+    service = aws_ecs_service.test.name
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_ecs_force_new_deployment.test]
+    }
+  }
+
+  depends_on = [aws_ecs_service.test]
+}
+`)
+}
+
+func testAccForceNewDeploymentActionConfig_nonExistentService(rName, serviceName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+}
+
+action "aws_ecs_force_new_deployment" "test" {
+  config {
+    cluster = aws_ecs_cluster.test.name
+    service = %[2]q
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_ecs_force_new_deployment.test]
+    }
+  }
+
+  depends_on = [aws_ecs_cluster.test]
+}
+`, rName, serviceName)
+}
@@ -1,7 +1,7 @@
 // Copyright IBM Corp. 2014, 2025
 // SPDX-License-Identifier: MPL-2.0
 
-//go:generate go run ../../generate/listpages/main.go -ListOps=DescribeCapacityProviders,ListServiceDeployments
+//go:generate go run ../../generate/listpages/main.go -ListOps=DescribeCapacityProviders,ListClusters,ListServiceDeployments,ListServices,ListTaskDefinitionFamilies
 //go:generate go run ../../generate/tagresource/main.go
 //go:generate go run ../../generate/tags/main.go -GetTag -ListTags -ServiceTagsSlice -UpdateTags -CreateTags -ParentNotFoundErrCode=InvalidParameterException "-ParentNotFoundErrMsg=The specified cluster is inactive. Specify an active cluster and try again."
 //go:generate go run ../../generate/servicepackage/main.go
@@ -23,6 +23,10 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ecs/document"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
@@ -32,20 +36,30 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2/importer"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2/types/nullable"
 	"github.com/hashicorp/terraform-provider-aws/internal/smithy"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // @SDKResource("aws_ecs_service", name="Service")
 // @Tags(identifierAttribute="arn")
+// @ArnIdentity
+// @CustomImport
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ecs/types;awstypes;awstypes.Service")
+// @Testing(importIgnore="task_definition;wait_for_steady_state")
+// @Testing(preIdentityVersion="v6.26.0")
 func resourceService() *schema.Resource {
 	// Resource with v0 schema (provider v5.58.0).
 	resourceV0 := &schema.Resource{
@@ -1338,6 +1352,107 @@ func resourceService() *schema.Resource {
 	}
 }
 
+// @SDKListResource("aws_ecs_service")
+func serviceResourceAsListResource() inttypes.ListResourceForSDK {
+	l := serviceListResource{}
+	l.SetResourceSchema(resourceService())
+
+	return &l
+}
+
+type serviceListResource struct {
+	framework.ResourceWithConfigure
+	framework.ListResourceWithSDKv2Resource
+	framework.ListResourceWithSDKv2Tags
+}
+
+type serviceListResourceModel struct {
+	framework.WithRegionModel
+	Cluster types.String `tfsdk:"cluster"`
+}
+
+func (l *serviceListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"cluster": listschema.StringAttribute{
+				Description: "Name or ARN of the ECS cluster whose services are listed.",
+				Required:    true,
+			},
+		},
+		Blocks: map[string]listschema.Block{},
+	}
+}
+
+func (l *serviceListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var query serviceListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	awsClient := l.Meta()
+	conn := awsClient.ECSClient(ctx)
+
+	cluster := query.Cluster.ValueString()
+	ctx = tflog.SetField(ctx, "cluster", cluster)
+
+	tflog.Info(ctx, "Listing ECS services")
+	stream.Results = func(yield func(list.ListResult) bool) {
+		input := ecs.ListServicesInput{
+			Cluster: aws.String(cluster),
+		}
+		err := listServicesPages(ctx, conn, &input, func(page *ecs.ListServicesOutput, lastPage bool) bool {
+			for _, serviceARN := range page.ServiceArns {
+				ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), serviceARN)
+
+				result := request.NewListResult(ctx)
+
+				rd := l.ResourceData()
+				rd.SetId(serviceARN)
+				rd.Set("cluster", cluster)
+
+				diags := resourceServiceRead(ctx, rd, awsClient)
+				if diags.HasError() || rd.Id() == "" {
+					// Resource can't be read or is logically deleted.
+					// Log and continue.
+					tflog.Error(ctx, "Reading ECS service", map[string]any{
+						names.AttrID: serviceARN,
+						"diags":      sdkdiag.DiagnosticsString(diags),
+					})
+					continue
+				}
+
+				if err := l.SetTags(ctx, awsClient, rd); err != nil {
+					result = fwdiag.NewListResultErrorDiagnostic(err)
+					yield(result)
+					return false
+				}
+
+				result.DisplayName = rd.Get(names.AttrName).(string)
+
+				l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+				if result.Diagnostics.HasError() {
+					yield(result)
+					return false
+				}
+
+				if !yield(result) {
+					return false
+				}
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			result := fwdiag.NewListResultErrorDiagnostic(err)
+			yield(result)
+		}
+	}
+}
+
 func resourceServiceCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ECSClient(ctx)
@@ -1989,6 +2104,28 @@ func resourceServiceDelete(ctx context.Context, d *schema.ResourceData, meta any
 }
 
 func resourceServiceImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	if d.Id() == "" || arn.IsARN(d.Id()) {
+		if err := importer.RegionalARN(ctx, d, importer.IdentitySpec(ctx)); err != nil {
+			return nil, err
+		}
+
+		serviceARN, err := arn.Parse(d.Id())
+		if err != nil {
+			return nil, err
+		}
+
+		// Only the long ARN format (service/cluster-name/service-name) identifies the cluster.
+		parts := strings.Split(serviceARN.Resource, "/")
+		if len(parts) != 3 {
+			return nil, fmt.Errorf("wrong format of resource: %s, expecting 'arn:PARTITION:ecs:REGION:ACCOUNTID:service/cluster-name/service-name'", d.Id())
+		}
+
+		serviceARN.Resource = "cluster/" + parts[1]
+		d.Set("cluster", serviceARN.String())
+
+		return []*schema.ResourceData{d}, nil
+	}
+
 	parts := strings.Split(d.Id(), "/")
 	if len(parts) != 2 {
 		return []*schema.ResourceData{}, fmt.Errorf("wrong format of resource: %s, expecting 'cluster-name/service-name'", d.Id())
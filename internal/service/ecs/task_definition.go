@@ -17,6 +17,10 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ecs"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -26,18 +30,28 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2/importer"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // @SDKResource("aws_ecs_task_definition", name="Task Definition")
 // @Tags(identifierAttribute="arn")
+// @ArnIdentity
+// @MutableIdentity
+// @CustomImport
+// @Testing(identityTest=false)
 func resourceTaskDefinition() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
@@ -48,6 +62,12 @@ func resourceTaskDefinition() *schema.Resource {
 
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+				if d.Id() == "" {
+					if err := importer.RegionalARN(ctx, d, importer.IdentitySpec(ctx)); err != nil {
+						return nil, err
+					}
+				}
+
 				d.Set(names.AttrARN, d.Id())
 
 				idErr := fmt.Errorf("Expected ID in format of arn:PARTITION:ecs:REGION:ACCOUNTID:task-definition/FAMILY:REVISION and provided: %s", d.Id())
@@ -531,6 +551,162 @@ func resourceTaskDefinition() *schema.Resource {
 	}
 }
 
+// @SDKListResource("aws_ecs_task_definition")
+func taskDefinitionResourceAsListResource() inttypes.ListResourceForSDK {
+	l := taskDefinitionListResource{}
+	l.SetResourceSchema(resourceTaskDefinition())
+
+	return &l
+}
+
+type taskDefinitionListResource struct {
+	framework.ResourceWithConfigure
+	framework.ListResourceWithSDKv2Resource
+	framework.ListResourceWithSDKv2Tags
+}
+
+type taskDefinitionListResourceModel struct {
+	framework.WithRegionModel
+	FamilyPrefix       types.String                                      `tfsdk:"family_prefix"`
+	LatestRevisionOnly types.Bool                                        `tfsdk:"latest_revision_only"`
+	Status             fwtypes.StringEnum[awstypes.TaskDefinitionStatus] `tfsdk:"status"`
+}
+
+func (l *taskDefinitionListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"family_prefix": listschema.StringAttribute{
+				Description: "Prefix of the families of the task definitions to list.",
+				Optional:    true,
+			},
+			"latest_revision_only": listschema.BoolAttribute{
+				Description: "Whether to list only the latest revision of each task definition family.",
+				Optional:    true,
+			},
+			names.AttrStatus: listschema.StringAttribute{
+				CustomType:  fwtypes.StringEnumType[awstypes.TaskDefinitionStatus](),
+				Description: "Status of the task definitions to list. Defaults to `ACTIVE`.",
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]listschema.Block{},
+	}
+}
+
+func (l *taskDefinitionListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var query taskDefinitionListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	awsClient := l.Meta()
+	conn := awsClient.ECSClient(ctx)
+
+	latestRevisionOnly := query.LatestRevisionOnly.ValueBool()
+
+	tflog.Info(ctx, "Listing ECS task definitions")
+	stream.Results = func(yield func(list.ListResult) bool) {
+		// ListTaskDefinitions only matches the full family name, so families matching the prefix are listed first.
+		// A nil family lists the task definitions in all families.
+		families := []*string{nil}
+		if v := query.FamilyPrefix.ValueString(); v != "" {
+			input := ecs.ListTaskDefinitionFamiliesInput{
+				FamilyPrefix: aws.String(v),
+				Status:       awstypes.TaskDefinitionFamilyStatusAll,
+			}
+			output, err := findTaskDefinitionFamilies(ctx, conn, &input)
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			families = aws.StringSlice(output)
+		}
+
+		for _, familyPrefix := range families {
+			input := ecs.ListTaskDefinitionsInput{
+				FamilyPrefix: familyPrefix,
+				Status:       query.Status.ValueEnum(),
+			}
+			if latestRevisionOnly {
+				// Revisions within a family are returned newest first, so the first revision seen is the latest.
+				input.Sort = awstypes.SortOrderDesc
+			}
+			seen := make(map[string]struct{})
+
+			pages := ecs.NewListTaskDefinitionsPaginator(conn, &input)
+			for pages.HasMorePages() {
+				page, err := pages.NextPage(ctx)
+				if err != nil {
+					result := fwdiag.NewListResultErrorDiagnostic(err)
+					yield(result)
+					return
+				}
+
+				for _, taskDefinitionARN := range page.TaskDefinitionArns {
+					if latestRevisionOnly {
+						family := taskDefinitionARNStripRevision(taskDefinitionARN)
+						if _, ok := seen[family]; ok {
+							continue
+						}
+						seen[family] = struct{}{}
+					}
+
+					ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrARN), taskDefinitionARN)
+
+					result := request.NewListResult(ctx)
+
+					rd := l.ResourceData()
+					rd.SetId(taskDefinitionARN)
+					rd.Set(names.AttrARN, taskDefinitionARN)
+
+					// The resource Read treats INACTIVE and DELETE_IN_PROGRESS revisions as deleted,
+					// so describe the revision directly to support listing by any status.
+					taskDefinition, tags, err := findTaskDefinitionWithTags(ctx, conn, taskDefinitionARN)
+					if retry.NotFound(err) {
+						continue
+					}
+					if err != nil {
+						result = fwdiag.NewListResultErrorDiagnostic(fmt.Errorf("reading ECS Task Definition (%s): %w", taskDefinitionARN, err))
+						yield(result)
+						return
+					}
+
+					if diags := resourceTaskDefinitionFlatten(ctx, rd, taskDefinition, tags); diags.HasError() {
+						tflog.Error(ctx, "Reading ECS task definition", map[string]any{
+							names.AttrARN: taskDefinitionARN,
+							"diags":       sdkdiag.DiagnosticsString(diags),
+						})
+						continue
+					}
+
+					if err := l.SetTags(ctx, awsClient, rd); err != nil {
+						result = fwdiag.NewListResultErrorDiagnostic(err)
+						yield(result)
+						return
+					}
+
+					result.DisplayName = fmt.Sprintf("%s:%d", rd.Get(names.AttrFamily).(string), rd.Get("revision").(int))
+
+					l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+					if result.Diagnostics.HasError() {
+						yield(result)
+						return
+					}
+
+					if !yield(result) {
+						return
+					}
+				}
+			}
+		}
+	}
+}
+
 func resourceTaskDefinitionCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ECSClient(ctx)
@@ -662,6 +838,12 @@ func resourceTaskDefinitionRead(ctx context.Context, d *schema.ResourceData, met
 		return sdkdiag.AppendErrorf(diags, "reading ECS Task Definition (%s): %s", familyOrARN, err)
 	}
 
+	return append(diags, resourceTaskDefinitionFlatten(ctx, d, taskDefinition, tags)...)
+}
+
+func resourceTaskDefinitionFlatten(ctx context.Context, d *schema.ResourceData, taskDefinition *awstypes.TaskDefinition, tags []awstypes.Tag) diag.Diagnostics {
+	var diags diag.Diagnostics
+
 	d.SetId(aws.ToString(taskDefinition.Family))
 	arn := aws.ToString(taskDefinition.TaskDefinitionArn)
 	d.Set(names.AttrARN, arn)
@@ -767,6 +949,44 @@ func findTaskDefinition(ctx context.Context, conn *ecs.Client, input *ecs.Descri
 }
 
 func findTaskDefinitionByFamilyOrARN(ctx context.Context, conn *ecs.Client, familyOrARN string) (*awstypes.TaskDefinition, []awstypes.Tag, error) {
+	taskDefinition, tags, err := findTaskDefinitionWithTags(ctx, conn, familyOrARN)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if status := taskDefinition.Status; status == awstypes.TaskDefinitionStatusInactive || status == awstypes.TaskDefinitionStatusDeleteInProgress {
+		return nil, nil, &sdkretry.NotFoundError{
+			Message:     string(status),
+			LastRequest: familyOrARN,
+		}
+	}
+
+	return taskDefinition, tags, nil
+}
+
+func findTaskDefinitionFamilies(ctx context.Context, conn *ecs.Client, input *ecs.ListTaskDefinitionFamiliesInput) ([]string, error) {
+	var output []string
+
+	err := listTaskDefinitionFamiliesPages(ctx, conn, input, func(page *ecs.ListTaskDefinitionFamiliesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		output = append(output, page.Families...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// findTaskDefinitionWithTags returns the task definition regardless of its status.
+func findTaskDefinitionWithTags(ctx context.Context, conn *ecs.Client, familyOrARN string) (*awstypes.TaskDefinition, []awstypes.Tag, error) {
 	input := &ecs.DescribeTaskDefinitionInput{
 		Include:        []awstypes.TaskDefinitionField{awstypes.TaskDefinitionFieldTags},
 		TaskDefinition: aws.String(familyOrARN),
@@ -781,18 +1001,7 @@ func findTaskDefinitionByFamilyOrARN(ctx context.Context, conn *ecs.Client, fami
 		taskDefinition, tags, err = findTaskDefinition(ctx, conn, input)
 	}
 
-	if err != nil {
-		return nil, nil, err
-	}
-
-	if status := taskDefinition.Status; status == awstypes.TaskDefinitionStatusInactive || status == awstypes.TaskDefinitionStatusDeleteInProgress {
-		return nil, nil, &sdkretry.NotFoundError{
-			Message:     string(status),
-			LastRequest: input,
-		}
-	}
-
-	return taskDefinition, tags, nil
+	return taskDefinition, tags, err
 }
 
 func validTaskDefinitionContainerDefinitions(v any, k string) (ws []string, errors []error) {
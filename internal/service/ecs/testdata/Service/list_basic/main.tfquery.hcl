@@ -0,0 +1,10 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+list "aws_ecs_service" "test" {
+  provider = aws
+
+  config {
+    cluster = aws_ecs_cluster.test.name
+  }
+}
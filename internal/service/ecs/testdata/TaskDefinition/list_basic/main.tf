@@ -0,0 +1,24 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_ecs_task_definition" "test" {
+  count = 2
+
+  family = "${var.rName}-${count.index}"
+
+  container_definitions = jsonencode([
+    {
+      cpu       = 128
+      essential = true
+      image     = "mongo:latest"
+      memory    = 128
+      name      = "mongodb"
+    },
+  ])
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
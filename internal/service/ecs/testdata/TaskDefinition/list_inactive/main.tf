@@ -0,0 +1,29 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+# Changing the container definitions replaces the resource, deregistering the previous revision.
+resource "aws_ecs_task_definition" "test" {
+  family = var.rName
+
+  container_definitions = jsonencode([
+    {
+      cpu       = var.cpu
+      essential = true
+      image     = "mongo:latest"
+      memory    = 128
+      name      = "mongodb"
+    },
+  ])
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
+
+variable "cpu" {
+  description = "CPU units for the container"
+  type        = number
+  nullable    = false
+}
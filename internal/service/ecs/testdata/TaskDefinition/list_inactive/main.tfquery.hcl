@@ -0,0 +1,11 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+list "aws_ecs_task_definition" "test" {
+  provider = aws
+
+  config {
+    family_prefix = var.rName
+    status        = "INACTIVE"
+  }
+}
@@ -0,0 +1,37 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_ecs_task_definition" "test" {
+  family = var.rName
+
+  container_definitions = jsonencode([
+    {
+      cpu       = 128
+      essential = true
+      image     = "mongo:latest"
+      memory    = 128
+      name      = "mongodb"
+    },
+  ])
+}
+
+# Registers a second, newer revision in the same family.
+resource "aws_ecs_task_definition" "test2" {
+  family = aws_ecs_task_definition.test.family
+
+  container_definitions = jsonencode([
+    {
+      cpu       = 256
+      essential = true
+      image     = "mongo:latest"
+      memory    = 256
+      name      = "mongodb"
+    },
+  ])
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
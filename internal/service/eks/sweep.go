@@ -19,12 +19,12 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_eks_addon", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_eks_addon", &resource.Sweeper{
 		Name: "aws_eks_addon",
 		F:    sweepAddons,
 	})
 
-	resource.AddTestSweepers("aws_eks_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_eks_cluster", &resource.Sweeper{
 		Name: "aws_eks_cluster",
 		F:    sweepClusters,
 		Dependencies: []string{
@@ -36,17 +36,17 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_eks_fargate_profile", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_eks_fargate_profile", &resource.Sweeper{
 		Name: "aws_eks_fargate_profile",
 		F:    sweepFargateProfiles,
 	})
 
-	resource.AddTestSweepers("aws_eks_identity_provider_config", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_eks_identity_provider_config", &resource.Sweeper{
 		Name: "aws_eks_identity_provider_config",
 		F:    sweepIdentityProvidersConfig,
 	})
 
-	resource.AddTestSweepers("aws_eks_node_group", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_eks_node_group", &resource.Sweeper{
 		Name: "aws_eks_node_group",
 		F:    sweepNodeGroups,
 	})
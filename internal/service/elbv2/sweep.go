@@ -18,7 +18,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_lb", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_lb", &resource.Sweeper{
 		Name: "aws_lb",
 		F:    sweepLoadBalancers,
 		Dependencies: []string{
@@ -28,7 +28,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_lb_target_group", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_lb_target_group", &resource.Sweeper{
 		Name: "aws_lb_target_group",
 		F:    sweepTargetGroups,
 		Dependencies: []string{
@@ -36,7 +36,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_lb_listener", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_lb_listener", &resource.Sweeper{
 		Name: "aws_lb_listener",
 		F:    sweepListeners,
 	})
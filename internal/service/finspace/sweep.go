@@ -16,7 +16,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_finspace_kx_environment", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_finspace_kx_environment", &resource.Sweeper{
 		Name: "aws_finspace_kx_environment",
 		F:    sweepKxEnvironments,
 	})
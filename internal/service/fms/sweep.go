@@ -26,7 +26,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_fms_admin_account", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_fms_admin_account", &resource.Sweeper{
 		Name: "aws_fms_admin_account",
 		F:    sweepAdminAccount,
 	})
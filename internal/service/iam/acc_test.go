@@ -15,6 +15,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
 	"github.com/hashicorp/terraform-provider-aws/names"
 	"golang.org/x/crypto/ssh"
 )
@@ -63,6 +64,7 @@ func pemEncode(b []byte, block string) (string, error) {
 
 func init() {
 	acctest.RegisterServiceErrorCheckFunc(names.IAMServiceID, testAccErrorCheckSkip)
+	vcr.RegisterRedactionRules(names.IAMServiceID, "//AccessKey/SecretAccessKey", "$..Password", "$..NewPassword", "$..OldPassword")
 }
 
 func testAccErrorCheckSkip(t *testing.T) resource.ErrorCheckFunc {
@@ -0,0 +1,250 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package iam_test
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fakeaws"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func init() {
+	fakeaws.RegisterService(names.IAM, newFakeService)
+}
+
+type fakeRole struct {
+	assumeRolePolicyDocument string
+	attachedPolicyARNs       []string
+	createDate               time.Time
+	description              string
+	inlinePolicies           map[string]string
+	maxSessionDuration       int64
+	name                     string
+	path                     string
+	permissionsBoundary      string
+	roleID                   string
+	tags                     fakeaws.Tags
+}
+
+func (r *fakeRole) apiObject() map[string]any {
+	apiObject := map[string]any{
+		"Arn":                      fakeaws.GlobalARN("iam", "role"+r.path+r.name),
+		"AssumeRolePolicyDocument": url.QueryEscape(r.assumeRolePolicyDocument),
+		"CreateDate":               r.createDate,
+		"Description":              r.description,
+		"MaxSessionDuration":       r.maxSessionDuration,
+		"Path":                     r.path,
+		"RoleId":                   r.roleID,
+		"RoleName":                 r.name,
+		"Tags":                     r.tags.List(),
+	}
+	if r.permissionsBoundary != "" {
+		apiObject["PermissionsBoundary"] = map[string]any{
+			"PermissionsBoundaryArn":  r.permissionsBoundary,
+			"PermissionsBoundaryType": "Policy",
+		}
+	}
+
+	return apiObject
+}
+
+// newFakeService returns a fake IAM implementing role management.
+func newFakeService() fakeaws.Operations {
+	// IAM is global, so roles are keyed by name only.
+	roles := make(map[string]*fakeRole)
+	var roleCount int
+
+	findRole := func(input map[string]any) (*fakeRole, error) {
+		name := fakeaws.String(input, "RoleName")
+		r, ok := roles[name]
+		if !ok {
+			return nil, fakeaws.NewNotFoundError("NoSuchEntity", "The role with name %s cannot be found.", name)
+		}
+
+		return r, nil
+	}
+
+	// withRole adapts a handler that operates on an existing role.
+	withRole := func(f func(*fakeRole, map[string]any) (map[string]any, error)) fakeaws.Operation {
+		return func(_ context.Context, input map[string]any) (map[string]any, error) {
+			r, err := findRole(input)
+			if err != nil {
+				return nil, err
+			}
+
+			return f(r, input)
+		}
+	}
+
+	return fakeaws.Operations{
+		"CreateRole": func(_ context.Context, input map[string]any) (map[string]any, error) {
+			name := fakeaws.String(input, "RoleName")
+			if _, ok := roles[name]; ok {
+				return nil, fakeaws.NewError("EntityAlreadyExists", "Role with name %s already exists.", name)
+			}
+
+			roleCount++
+			r := &fakeRole{
+				assumeRolePolicyDocument: fakeaws.String(input, "AssumeRolePolicyDocument"),
+				createDate:               fakeaws.Now(),
+				description:              fakeaws.String(input, "Description"),
+				inlinePolicies:           make(map[string]string),
+				maxSessionDuration:       fakeaws.Int(input, "MaxSessionDuration"),
+				name:                     name,
+				path:                     fakeaws.String(input, "Path"),
+				permissionsBoundary:      fakeaws.String(input, "PermissionsBoundary"),
+				roleID:                   fmt.Sprintf("AROA%017X", roleCount),
+				tags:                     make(fakeaws.Tags),
+			}
+			if r.maxSessionDuration == 0 {
+				r.maxSessionDuration = 3600
+			}
+			if r.path == "" {
+				r.path = "/"
+			}
+			r.tags.AddList(fakeaws.List(input, "Tags"))
+			roles[name] = r
+
+			return map[string]any{"Role": r.apiObject()}, nil
+		},
+		"GetRole": withRole(func(r *fakeRole, _ map[string]any) (map[string]any, error) {
+			return map[string]any{"Role": r.apiObject()}, nil
+		}),
+		"DeleteRole": withRole(func(r *fakeRole, _ map[string]any) (map[string]any, error) {
+			if len(r.attachedPolicyARNs) > 0 || len(r.inlinePolicies) > 0 {
+				return nil, fakeaws.NewError("DeleteConflict", "Cannot delete entity, must detach all policies first.")
+			}
+
+			delete(roles, r.name)
+
+			return nil, nil
+		}),
+		"UpdateAssumeRolePolicy": withRole(func(r *fakeRole, input map[string]any) (map[string]any, error) {
+			r.assumeRolePolicyDocument = fakeaws.String(input, "PolicyDocument")
+
+			return nil, nil
+		}),
+		"UpdateRole": withRole(func(r *fakeRole, input map[string]any) (map[string]any, error) {
+			if _, ok := input["Description"]; ok {
+				r.description = fakeaws.String(input, "Description")
+			}
+			if v := fakeaws.Int(input, "MaxSessionDuration"); v != 0 {
+				r.maxSessionDuration = v
+			}
+
+			return nil, nil
+		}),
+		"UpdateRoleDescription": withRole(func(r *fakeRole, input map[string]any) (map[string]any, error) {
+			r.description = fakeaws.String(input, "Description")
+
+			return map[string]any{"Role": r.apiObject()}, nil
+		}),
+		"PutRolePermissionsBoundary": withRole(func(r *fakeRole, input map[string]any) (map[string]any, error) {
+			r.permissionsBoundary = fakeaws.String(input, "PermissionsBoundary")
+
+			return nil, nil
+		}),
+		"DeleteRolePermissionsBoundary": withRole(func(r *fakeRole, _ map[string]any) (map[string]any, error) {
+			r.permissionsBoundary = ""
+
+			return nil, nil
+		}),
+		"AttachRolePolicy": withRole(func(r *fakeRole, input map[string]any) (map[string]any, error) {
+			if arn := fakeaws.String(input, "PolicyArn"); !slices.Contains(r.attachedPolicyARNs, arn) {
+				r.attachedPolicyARNs = append(r.attachedPolicyARNs, arn)
+			}
+
+			return nil, nil
+		}),
+		"DetachRolePolicy": withRole(func(r *fakeRole, input map[string]any) (map[string]any, error) {
+			arn := fakeaws.String(input, "PolicyArn")
+			if !slices.Contains(r.attachedPolicyARNs, arn) {
+				return nil, fakeaws.NewNotFoundError("NoSuchEntity", "Policy %s was not found.", arn)
+			}
+
+			r.attachedPolicyARNs = slices.DeleteFunc(r.attachedPolicyARNs, func(v string) bool { return v == arn })
+
+			return nil, nil
+		}),
+		"ListAttachedRolePolicies": withRole(func(r *fakeRole, _ map[string]any) (map[string]any, error) {
+			policies := make([]any, 0, len(r.attachedPolicyARNs))
+			for _, arn := range r.attachedPolicyARNs {
+				policies = append(policies, map[string]any{"PolicyArn": arn})
+			}
+
+			return map[string]any{
+				"AttachedPolicies": policies,
+				"IsTruncated":      false,
+			}, nil
+		}),
+		"PutRolePolicy": withRole(func(r *fakeRole, input map[string]any) (map[string]any, error) {
+			r.inlinePolicies[fakeaws.String(input, "PolicyName")] = fakeaws.String(input, "PolicyDocument")
+
+			return nil, nil
+		}),
+		"GetRolePolicy": withRole(func(r *fakeRole, input map[string]any) (map[string]any, error) {
+			name := fakeaws.String(input, "PolicyName")
+			document, ok := r.inlinePolicies[name]
+			if !ok {
+				return nil, fakeaws.NewNotFoundError("NoSuchEntity", "The role policy with name %s cannot be found.", name)
+			}
+
+			return map[string]any{
+				"PolicyDocument": url.QueryEscape(document),
+				"PolicyName":     name,
+				"RoleName":       r.name,
+			}, nil
+		}),
+		"DeleteRolePolicy": withRole(func(r *fakeRole, input map[string]any) (map[string]any, error) {
+			name := fakeaws.String(input, "PolicyName")
+			if _, ok := r.inlinePolicies[name]; !ok {
+				return nil, fakeaws.NewNotFoundError("NoSuchEntity", "The role policy with name %s cannot be found.", name)
+			}
+
+			delete(r.inlinePolicies, name)
+
+			return nil, nil
+		}),
+		"ListRolePolicies": withRole(func(r *fakeRole, _ map[string]any) (map[string]any, error) {
+			policyNames := make([]any, 0, len(r.inlinePolicies))
+			for _, name := range slices.Sorted(maps.Keys(r.inlinePolicies)) {
+				policyNames = append(policyNames, name)
+			}
+
+			return map[string]any{
+				"IsTruncated": false,
+				"PolicyNames": policyNames,
+			}, nil
+		}),
+		"ListInstanceProfilesForRole": withRole(func(*fakeRole, map[string]any) (map[string]any, error) {
+			// Instance profiles are not implemented.
+			return map[string]any{
+				"InstanceProfiles": []any{},
+				"IsTruncated":      false,
+			}, nil
+		}),
+		"TagRole": withRole(func(r *fakeRole, input map[string]any) (map[string]any, error) {
+			r.tags.AddList(fakeaws.List(input, "Tags"))
+
+			return nil, nil
+		}),
+		"UntagRole": withRole(func(r *fakeRole, input map[string]any) (map[string]any, error) {
+			r.tags.Remove(fakeaws.List(input, "TagKeys"))
+
+			return nil, nil
+		}),
+		"ListRoleTags": withRole(func(r *fakeRole, _ map[string]any) (map[string]any, error) {
+			return map[string]any{
+				"IsTruncated": false,
+				"Tags":        r.tags.List(),
+			}, nil
+		}),
+	}
+}
@@ -16,7 +16,7 @@ import (
 
 func RegisterSweepers() {
 	// No need to have separate sweeper for table as would be destroyed as part of keyspace
-	resource.AddTestSweepers("aws_keyspaces_keyspace", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_keyspaces_keyspace", &resource.Sweeper{
 		Name: "aws_keyspaces_keyspace",
 		F:    sweepKeyspaces,
 	})
@@ -20,7 +20,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_kms_key", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_kms_key", &resource.Sweeper{
 		Name: "aws_kms_key",
 		F:    sweepKeys,
 	})
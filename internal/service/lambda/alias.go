@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -19,12 +20,17 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2/importer"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // @SDKResource("aws_lambda_alias", name="Alias")
+// @ArnIdentity
+// @CustomImport
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/lambda;lambda.GetAliasOutput")
+// @Testing(preIdentityVersion="v6.26.0")
 func resourceAlias() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceAliasCreate,
@@ -179,6 +185,28 @@ func resourceAliasDelete(ctx context.Context, d *schema.ResourceData, meta any)
 }
 
 func resourceAliasImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	if d.Id() == "" || arn.IsARN(d.Id()) {
+		if err := importer.RegionalARN(ctx, d, importer.IdentitySpec(ctx)); err != nil {
+			return nil, err
+		}
+
+		aliasARN, err := arn.Parse(d.Id())
+		if err != nil {
+			return nil, err
+		}
+
+		// Alias ARNs have the form arn:PARTITION:lambda:REGION:ACCOUNTID:function:FUNCTION_NAME:ALIAS.
+		parts := strings.Split(aliasARN.Resource, ":")
+		if len(parts) != 3 || parts[0] != "function" || parts[1] == "" || parts[2] == "" {
+			return nil, fmt.Errorf("Unexpected format of ARN (%q), expected arn:PARTITION:lambda:REGION:ACCOUNTID:function:FUNCTION_NAME:ALIAS", d.Id())
+		}
+
+		d.Set("function_name", parts[1])
+		d.Set(names.AttrName, parts[2])
+
+		return []*schema.ResourceData{d}, nil
+	}
+
 	idParts := strings.Split(d.Id(), "/")
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
 		return nil, fmt.Errorf("Unexpected format of ID (%q), expected FUNCTION_NAME/ALIAS", d.Id())
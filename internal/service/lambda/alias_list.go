@@ -0,0 +1,119 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_lambda_alias")
+func aliasResourceAsListResource() inttypes.ListResourceForSDK {
+	l := aliasListResource{}
+	l.SetResourceSchema(resourceAlias())
+
+	return &l
+}
+
+var _ list.ListResourceWithRawV5Schemas = &aliasListResource{}
+
+type aliasListResource struct {
+	framework.ResourceWithConfigure
+	framework.ListResourceWithSDKv2Resource
+}
+
+type aliasListResourceModel struct {
+	framework.WithRegionModel
+	FunctionName types.String `tfsdk:"function_name"`
+}
+
+func (l *aliasListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"function_name": listschema.StringAttribute{
+				Description: "Name or ARN of the Lambda function whose aliases are listed.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+func (l *aliasListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var query aliasListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	awsClient := l.Meta()
+	conn := awsClient.LambdaClient(ctx)
+
+	functionName := query.FunctionName.ValueString()
+	ctx = tflog.SetField(ctx, logging.ResourceAttributeKey("function_name"), functionName)
+
+	tflog.Info(ctx, "Listing Lambda aliases")
+	stream.Results = func(yield func(list.ListResult) bool) {
+		input := lambda.ListAliasesInput{
+			FunctionName: aws.String(functionName),
+		}
+		pages := lambda.NewListAliasesPaginator(conn, &input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			for _, v := range page.Aliases {
+				aliasARN, aliasName := aws.ToString(v.AliasArn), aws.ToString(v.Name)
+				ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrARN), aliasARN)
+
+				result := request.NewListResult(ctx)
+
+				rd := l.ResourceData()
+				rd.SetId(aliasARN)
+				rd.Set("function_name", functionName)
+				rd.Set(names.AttrName, aliasName)
+
+				diags := resourceAliasRead(ctx, rd, awsClient)
+				if diags.HasError() || rd.Id() == "" {
+					// Resource can't be read or is logically deleted.
+					// Log and continue.
+					tflog.Error(ctx, "Reading Lambda alias", map[string]any{
+						names.AttrARN: aliasARN,
+						"diags":       sdkdiag.DiagnosticsString(diags),
+					})
+					continue
+				}
+
+				result.DisplayName = aliasName
+
+				l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+				if result.Diagnostics.HasError() {
+					yield(result)
+					return
+				}
+
+				if !yield(result) {
+					return
+				}
+			}
+		}
+	}
+}
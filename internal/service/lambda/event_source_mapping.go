@@ -34,6 +34,10 @@ import (
 
 // @SDKResource("aws_lambda_event_source_mapping", name="Event Source Mapping")
 // @Tags(identifierAttribute="arn")
+// @IdentityAttribute("uuid")
+// @Testing(idAttrDuplicates="uuid")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/lambda;lambda.GetEventSourceMappingOutput")
+// @Testing(preIdentityVersion="v6.26.0")
 // @Testing(tagsTest=false)
 func resourceEventSourceMapping() *schema.Resource {
 	return &schema.Resource{
@@ -42,10 +46,6 @@ func resourceEventSourceMapping() *schema.Resource {
 		UpdateWithoutTimeout: resourceEventSourceMappingUpdate,
 		DeleteWithoutTimeout: resourceEventSourceMappingDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Schema: map[string]*schema.Schema{
 			"amazon_managed_kafka_event_source_config": {
 				Type:          schema.TypeList,
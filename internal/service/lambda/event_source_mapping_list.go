@@ -0,0 +1,129 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// @SDKListResource("aws_lambda_event_source_mapping")
+func eventSourceMappingResourceAsListResource() inttypes.ListResourceForSDK {
+	l := eventSourceMappingListResource{}
+	l.SetResourceSchema(resourceEventSourceMapping())
+
+	return &l
+}
+
+var _ list.ListResourceWithRawV5Schemas = &eventSourceMappingListResource{}
+
+type eventSourceMappingListResource struct {
+	framework.ResourceWithConfigure
+	framework.ListResourceWithSDKv2Resource
+	framework.ListResourceWithSDKv2Tags
+}
+
+type eventSourceMappingListResourceModel struct {
+	framework.WithRegionModel
+	EventSourceARN fwtypes.ARN  `tfsdk:"event_source_arn"`
+	FunctionName   types.String `tfsdk:"function_name"`
+}
+
+func (l *eventSourceMappingListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"event_source_arn": listschema.StringAttribute{
+				CustomType:  fwtypes.ARNType,
+				Description: "ARN of the event source. Only event source mappings for the event source are listed.",
+				Optional:    true,
+			},
+			"function_name": listschema.StringAttribute{
+				Description: "Name or ARN of the Lambda function. Only event source mappings for the function are listed.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (l *eventSourceMappingListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var query eventSourceMappingListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	awsClient := l.Meta()
+	conn := awsClient.LambdaClient(ctx)
+
+	tflog.Info(ctx, "Listing Lambda event source mappings")
+	stream.Results = func(yield func(list.ListResult) bool) {
+		input := lambda.ListEventSourceMappingsInput{
+			EventSourceArn: fwflex.StringFromFramework(ctx, query.EventSourceARN),
+			FunctionName:   fwflex.StringFromFramework(ctx, query.FunctionName),
+		}
+		pages := lambda.NewListEventSourceMappingsPaginator(conn, &input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			for _, v := range page.EventSourceMappings {
+				uuid := aws.ToString(v.UUID)
+				ctx := tflog.SetField(ctx, logging.ResourceAttributeKey("uuid"), uuid)
+
+				result := request.NewListResult(ctx)
+
+				rd := l.ResourceData()
+				rd.SetId(uuid)
+
+				diags := resourceEventSourceMappingRead(ctx, rd, awsClient)
+				if diags.HasError() || rd.Id() == "" {
+					// Resource can't be read or is logically deleted.
+					// Log and continue.
+					tflog.Error(ctx, "Reading Lambda event source mapping", map[string]any{
+						"uuid":  uuid,
+						"diags": sdkdiag.DiagnosticsString(diags),
+					})
+					continue
+				}
+
+				if err := l.SetTags(ctx, awsClient, rd); err != nil {
+					result = fwdiag.NewListResultErrorDiagnostic(err)
+					yield(result)
+					return
+				}
+
+				result.DisplayName = uuid
+
+				l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+				if result.Diagnostics.HasError() {
+					yield(result)
+					return
+				}
+
+				if !yield(result) {
+					return
+				}
+			}
+		}
+	}
+}
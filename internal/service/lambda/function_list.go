@@ -0,0 +1,164 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_lambda_function")
+func functionResourceAsListResource() inttypes.ListResourceForSDK {
+	l := functionListResource{}
+	l.SetResourceSchema(resourceFunction())
+
+	return &l
+}
+
+var _ list.ListResourceWithRawV5Schemas = &functionListResource{}
+
+type functionListResource struct {
+	framework.ResourceWithConfigure
+	framework.ListResourceWithSDKv2Resource
+	framework.ListResourceWithSDKv2Tags
+}
+
+type functionListResourceModel struct {
+	framework.WithRegionModel
+	NamePrefix types.String                         `tfsdk:"name_prefix"`
+	Runtime    fwtypes.StringEnum[awstypes.Runtime] `tfsdk:"runtime"`
+	Tags       tftags.Map                           `tfsdk:"tags"`
+}
+
+func (l *functionListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			names.AttrNamePrefix: listschema.StringAttribute{
+				Description: "Function name prefix. Only functions whose names begin with the prefix are listed.",
+				Optional:    true,
+			},
+			"runtime": listschema.StringAttribute{
+				CustomType:  fwtypes.StringEnumType[awstypes.Runtime](),
+				Description: "Runtime identifier. Only functions using the runtime are listed.",
+				Optional:    true,
+			},
+			names.AttrTags: listschema.MapAttribute{
+				CustomType:  tftags.MapType,
+				ElementType: types.StringType,
+				Description: "Map of tags. Only functions that have all of the specified tags are listed.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (l *functionListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var query functionListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	awsClient := l.Meta()
+	conn := awsClient.LambdaClient(ctx)
+
+	namePrefix := query.NamePrefix.ValueString()
+	runtime := query.Runtime.ValueEnum()
+	filterTags := tftags.New(ctx, query.Tags)
+
+	tflog.Info(ctx, "Listing Lambda functions")
+	stream.Results = func(yield func(list.ListResult) bool) {
+		var input lambda.ListFunctionsInput
+		pages := lambda.NewListFunctionsPaginator(conn, &input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			for _, v := range page.Functions {
+				functionName := aws.ToString(v.FunctionName)
+
+				if namePrefix != "" && !strings.HasPrefix(functionName, namePrefix) {
+					continue
+				}
+
+				if runtime != "" && v.Runtime != runtime {
+					continue
+				}
+
+				// ListFunctions doesn't return tags, so filter on the function's tags before reading the function.
+				if len(filterTags) > 0 {
+					tags, err := listTags(ctx, conn, aws.ToString(v.FunctionArn))
+					if err != nil {
+						result := fwdiag.NewListResultErrorDiagnostic(err)
+						yield(result)
+						return
+					}
+
+					if !tags.IgnoreAWS().IgnoreConfig(awsClient.IgnoreTagsConfig(ctx)).ContainsAll(filterTags) {
+						continue
+					}
+				}
+
+				ctx := tflog.SetField(ctx, logging.ResourceAttributeKey("function_name"), functionName)
+
+				result := request.NewListResult(ctx)
+
+				rd := l.ResourceData()
+				rd.SetId(functionName)
+				rd.Set("function_name", functionName)
+
+				diags := resourceFunctionRead(ctx, rd, awsClient)
+				if diags.HasError() || rd.Id() == "" {
+					// Resource can't be read or is logically deleted.
+					// Log and continue.
+					tflog.Error(ctx, "Reading Lambda function", map[string]any{
+						"function_name": functionName,
+						"diags":         sdkdiag.DiagnosticsString(diags),
+					})
+					continue
+				}
+
+				if err := l.SetTags(ctx, awsClient, rd); err != nil {
+					result = fwdiag.NewListResultErrorDiagnostic(err)
+					yield(result)
+					return
+				}
+
+				result.DisplayName = functionName
+
+				l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+				if result.Diagnostics.HasError() {
+					yield(result)
+					return
+				}
+
+				if !yield(result) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,10 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+list "aws_lambda_alias" "test" {
+  provider = aws
+
+  config {
+    function_name = var.rName
+  }
+}
@@ -0,0 +1,10 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+list "aws_lambda_function" "test" {
+  provider = aws
+
+  config {
+    name_prefix = var.rName
+  }
+}
@@ -0,0 +1,41 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_lambda_function" "test" {
+  count = 3
+
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = "${var.rName}-${count.index}"
+  role          = aws_iam_role.test.arn
+  handler       = count.index == 2 ? "lambda_function.handler" : "exports.example"
+  runtime       = count.index == 2 ? "python3.12" : "nodejs20.x"
+
+  tags = {
+    Name = count.index == 0 ? var.rName : "${var.rName}-other"
+  }
+}
+
+resource "aws_iam_role" "test" {
+  name = var.rName
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "lambda.amazonaws.com"
+      },
+      "Effect": "Allow"
+    }
+  ]
+}
+EOF
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
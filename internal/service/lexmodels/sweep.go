@@ -16,24 +16,24 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_lex_bot_alias", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_lex_bot_alias", &resource.Sweeper{
 		Name: "aws_lex_bot_alias",
 		F:    sweepBotAliases,
 	})
 
-	resource.AddTestSweepers("aws_lex_bot", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_lex_bot", &resource.Sweeper{
 		Name:         "aws_lex_bot",
 		F:            sweepBots,
 		Dependencies: []string{"aws_lex_bot_alias"},
 	})
 
-	resource.AddTestSweepers("aws_lex_intent", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_lex_intent", &resource.Sweeper{
 		Name:         "aws_lex_intent",
 		F:            sweepIntents,
 		Dependencies: []string{"aws_lex_bot"},
 	})
 
-	resource.AddTestSweepers("aws_lex_slot_type", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_lex_slot_type", &resource.Sweeper{
 		Name:         "aws_lex_slot_type",
 		F:            sweepSlotTypes,
 		Dependencies: []string{"aws_lex_intent"},
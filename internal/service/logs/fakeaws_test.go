@@ -0,0 +1,216 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package logs_test
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fakeaws"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func init() {
+	fakeaws.RegisterService(names.Logs, newFakeService)
+}
+
+type fakeLogGroup struct {
+	arn                       string
+	creationTime              time.Time
+	deletionProtectionEnabled bool
+	kmsKeyID                  string
+	logGroupClass             string
+	name                      string
+	retentionInDays           int64
+	tags                      fakeaws.Tags
+}
+
+func (lg *fakeLogGroup) apiObject() map[string]any {
+	apiObject := map[string]any{
+		"arn":                       lg.arn + ":*",
+		"creationTime":              lg.creationTime.UnixMilli(),
+		"deletionProtectionEnabled": lg.deletionProtectionEnabled,
+		"logGroupArn":               lg.arn,
+		"logGroupClass":             lg.logGroupClass,
+		"logGroupName":              lg.name,
+		"storedBytes":               0,
+	}
+	if lg.kmsKeyID != "" {
+		apiObject["kmsKeyId"] = lg.kmsKeyID
+	}
+	if lg.retentionInDays != 0 {
+		apiObject["retentionInDays"] = lg.retentionInDays
+	}
+
+	return apiObject
+}
+
+// newFakeService returns a fake CloudWatch Logs implementing log group management.
+func newFakeService() fakeaws.Operations {
+	// Log groups keyed by ARN (without the ":*" suffix).
+	logGroups := make(map[string]*fakeLogGroup)
+
+	logGroupARN := func(ctx context.Context, name string) string {
+		return fakeaws.ARN(ctx, "logs", "log-group:"+name)
+	}
+
+	findLogGroup := func(ctx context.Context, input map[string]any) (*fakeLogGroup, error) {
+		arn := logGroupARN(ctx, fakeaws.String(input, "logGroupName"))
+		for _, k := range []string{"logGroupIdentifier", "resourceArn"} {
+			if v := strings.TrimSuffix(fakeaws.String(input, k), ":*"); v != "" {
+				arn = v
+				if !strings.HasPrefix(arn, "arn:") {
+					arn = logGroupARN(ctx, v)
+				}
+			}
+		}
+
+		lg, ok := logGroups[arn]
+		if !ok {
+			return nil, fakeaws.NewError("ResourceNotFoundException", "The specified log group does not exist.")
+		}
+
+		return lg, nil
+	}
+
+	return fakeaws.Operations{
+		"CreateLogGroup": func(ctx context.Context, input map[string]any) (map[string]any, error) {
+			name := fakeaws.String(input, "logGroupName")
+			arn := logGroupARN(ctx, name)
+
+			if _, ok := logGroups[arn]; ok {
+				return nil, fakeaws.NewError("ResourceAlreadyExistsException", "The specified log group already exists")
+			}
+
+			lg := &fakeLogGroup{
+				arn:                       arn,
+				creationTime:              fakeaws.Now(),
+				deletionProtectionEnabled: fakeaws.Bool(input, "deletionProtectionEnabled"),
+				kmsKeyID:                  fakeaws.String(input, "kmsKeyId"),
+				logGroupClass:             fakeaws.String(input, "logGroupClass"),
+				name:                      name,
+				tags:                      make(fakeaws.Tags),
+			}
+			if lg.logGroupClass == "" {
+				lg.logGroupClass = "STANDARD"
+			}
+			lg.tags.AddMap(fakeaws.Map(input, "tags"))
+			logGroups[arn] = lg
+
+			return nil, nil
+		},
+		"DescribeLogGroups": func(ctx context.Context, input map[string]any) (map[string]any, error) {
+			prefix := fakeaws.String(input, "logGroupNamePrefix")
+			apiObjects := make([]any, 0)
+			for _, arn := range slices.Sorted(maps.Keys(logGroups)) {
+				lg := logGroups[arn]
+				if !strings.HasPrefix(arn, logGroupARN(ctx, "")) || !strings.HasPrefix(lg.name, prefix) {
+					continue
+				}
+				apiObjects = append(apiObjects, lg.apiObject())
+			}
+
+			return map[string]any{
+				"logGroups": apiObjects,
+			}, nil
+		},
+		"DeleteLogGroup": func(ctx context.Context, input map[string]any) (map[string]any, error) {
+			lg, err := findLogGroup(ctx, input)
+			if err != nil {
+				return nil, err
+			}
+
+			if lg.deletionProtectionEnabled {
+				return nil, fakeaws.NewError("OperationAbortedException", "Log group %s has deletion protection enabled", lg.name)
+			}
+
+			delete(logGroups, lg.arn)
+
+			return nil, nil
+		},
+		"PutRetentionPolicy": func(ctx context.Context, input map[string]any) (map[string]any, error) {
+			lg, err := findLogGroup(ctx, input)
+			if err != nil {
+				return nil, err
+			}
+
+			lg.retentionInDays = fakeaws.Int(input, "retentionInDays")
+
+			return nil, nil
+		},
+		"DeleteRetentionPolicy": func(ctx context.Context, input map[string]any) (map[string]any, error) {
+			lg, err := findLogGroup(ctx, input)
+			if err != nil {
+				return nil, err
+			}
+
+			lg.retentionInDays = 0
+
+			return nil, nil
+		},
+		"PutLogGroupDeletionProtection": func(ctx context.Context, input map[string]any) (map[string]any, error) {
+			lg, err := findLogGroup(ctx, input)
+			if err != nil {
+				return nil, err
+			}
+
+			lg.deletionProtectionEnabled = fakeaws.Bool(input, "deletionProtectionEnabled")
+
+			return nil, nil
+		},
+		"AssociateKmsKey": func(ctx context.Context, input map[string]any) (map[string]any, error) {
+			lg, err := findLogGroup(ctx, input)
+			if err != nil {
+				return nil, err
+			}
+
+			lg.kmsKeyID = fakeaws.String(input, "kmsKeyId")
+
+			return nil, nil
+		},
+		"DisassociateKmsKey": func(ctx context.Context, input map[string]any) (map[string]any, error) {
+			lg, err := findLogGroup(ctx, input)
+			if err != nil {
+				return nil, err
+			}
+
+			lg.kmsKeyID = ""
+
+			return nil, nil
+		},
+		"TagResource": func(ctx context.Context, input map[string]any) (map[string]any, error) {
+			lg, err := findLogGroup(ctx, input)
+			if err != nil {
+				return nil, err
+			}
+
+			lg.tags.AddMap(fakeaws.Map(input, "tags"))
+
+			return nil, nil
+		},
+		"UntagResource": func(ctx context.Context, input map[string]any) (map[string]any, error) {
+			lg, err := findLogGroup(ctx, input)
+			if err != nil {
+				return nil, err
+			}
+
+			lg.tags.Remove(fakeaws.List(input, "tagKeys"))
+
+			return nil, nil
+		},
+		"ListTagsForResource": func(ctx context.Context, input map[string]any) (map[string]any, error) {
+			lg, err := findLogGroup(ctx, input)
+			if err != nil {
+				return nil, err
+			}
+
+			return map[string]any{
+				"tags": lg.tags.Map(),
+			}, nil
+		},
+	}
+}
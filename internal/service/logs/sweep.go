@@ -31,7 +31,7 @@ func RegisterSweepers() {
 
 	awsv2.Register("aws_cloudwatch_log_destination", sweepDestinations)
 
-	resource.AddTestSweepers("aws_cloudwatch_log_group", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_cloudwatch_log_group", &resource.Sweeper{
 		Name: "aws_cloudwatch_log_group",
 		F:    sweepGroups,
 		Dependencies: []string{
@@ -59,12 +59,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_query_definition", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_cloudwatch_query_definition", &resource.Sweeper{
 		Name: "aws_cloudwatch_query_definition",
 		F:    sweepQueryDefinitions,
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_log_resource_policy", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_cloudwatch_log_resource_policy", &resource.Sweeper{
 		Name: "aws_cloudwatch_log_resource_policy",
 		F:    sweepResourcePolicies,
 	})
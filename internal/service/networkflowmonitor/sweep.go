@@ -17,12 +17,12 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_networkflowmonitor_monitor", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_networkflowmonitor_monitor", &resource.Sweeper{
 		Name: "aws_networkflowmonitor_monitor",
 		F:    sweepMonitors,
 	})
 
-	resource.AddTestSweepers("aws_networkflowmonitor_scope", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_networkflowmonitor_scope", &resource.Sweeper{
 		Name: "aws_networkflowmonitor_scope",
 		F:    sweepScopes,
 	})
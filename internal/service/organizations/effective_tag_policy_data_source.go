@@ -0,0 +1,132 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package organizations
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tags/tagpolicy"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_organizations_effective_tag_policy", name="Effective Tag Policy")
+func newEffectiveTagPolicyDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &effectiveTagPolicyDataSource{}, nil
+}
+
+type effectiveTagPolicyDataSource struct {
+	framework.DataSourceWithModel[effectiveTagPolicyDataSourceModel]
+}
+
+func (d *effectiveTagPolicyDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			names.AttrResourceType: schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrRule: framework.DataSourceComputedListOfObjectAttribute[effectiveTagPolicyRuleModel](ctx),
+		},
+	}
+}
+
+func (d *effectiveTagPolicyDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data effectiveTagPolicyDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	resourceType, err := tagPolicyTerraformResourceType(fwflex.StringValueFromFramework(ctx, data.ResourceType))
+
+	if err != nil {
+		response.Diagnostics.AddAttributeError(path.Root(names.AttrResourceType), "Invalid Resource Type", err.Error())
+
+		return
+	}
+
+	// When tag policy compliance is enabled, the rules enforced by the provider, including any from tag_policy_file, are returned.
+	var rules []tftags.TagPolicyRule
+	if v := d.Meta().TagPolicyConfig(ctx); v != nil {
+		rules = v.Rules
+	} else {
+		conn := d.Meta().OrganizationsClient(ctx)
+
+		rules, err = tagpolicy.FindEffectiveTagPolicy(ctx, conn)
+
+		if err != nil {
+			response.Diagnostics.AddError("reading Organizations Effective Tag Policy", err.Error())
+
+			return
+		}
+	}
+
+	data.ID = fwflex.StringValueToFramework(ctx, d.Meta().AccountID(ctx)+"/"+resourceType)
+	response.Diagnostics.Append(fwflex.Flatten(ctx, flattenTagPolicyRules(rules, resourceType), &data.Rules)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// tagPolicyTerraformResourceType returns the Terraform resource type for the
+// specified Terraform or tag policy (e.g. "ec2:instance") resource type.
+func tagPolicyTerraformResourceType(resourceType string) (string, error) {
+	if v, ok := tagpolicy.Lookup[resourceType]; ok {
+		return v, nil
+	}
+
+	if slices.Contains(slices.Collect(maps.Values(tagpolicy.Lookup)), resourceType) {
+		return resourceType, nil
+	}
+
+	return "", fmt.Errorf("resource type %q is not supported by tag policies", resourceType)
+}
+
+type tagPolicyRule struct {
+	Enforced bool
+	Key      string
+	Required bool
+	Values   []string
+}
+
+func flattenTagPolicyRules(apiObjects []tftags.TagPolicyRule, resourceType string) []tagPolicyRule {
+	rules := make([]tagPolicyRule, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		rules = append(rules, tagPolicyRule{
+			Enforced: apiObject.IsEnforcedFor(resourceType),
+			Key:      apiObject.Key,
+			Required: apiObject.IsRequiredFor(resourceType),
+			Values:   apiObject.Values,
+		})
+	}
+
+	return rules
+}
+
+type effectiveTagPolicyDataSourceModel struct {
+	ID           types.String                                                 `tfsdk:"id"`
+	ResourceType types.String                                                 `tfsdk:"resource_type"`
+	Rules        fwtypes.ListNestedObjectValueOf[effectiveTagPolicyRuleModel] `tfsdk:"rule"`
+}
+
+type effectiveTagPolicyRuleModel struct {
+	Enforced types.Bool           `tfsdk:"enforced"`
+	Key      types.String         `tfsdk:"key"`
+	Required types.Bool           `tfsdk:"required"`
+	Values   fwtypes.ListOfString `tfsdk:"values"`
+}
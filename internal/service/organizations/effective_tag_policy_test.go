@@ -0,0 +1,83 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package organizations
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func TestEffectiveTagPolicyRules(t *testing.T) {
+	t.Parallel()
+
+	rules := []tftags.TagPolicyRule{
+		{
+			Key:         "CostCenter",
+			Values:      []string{"100", "200"},
+			EnforcedFor: []string{"aws_instance", "aws_s3_bucket"},
+			RequiredFor: []string{"aws_instance"},
+		},
+		{
+			Key:         "Owner",
+			EnforcedFor: []string{"aws_s3_bucket"},
+		},
+	}
+
+	testCases := map[string]struct {
+		resourceType  string
+		expected      []tagPolicyRule
+		expectedError bool
+	}{
+		"terraform resource type": {
+			resourceType: "aws_instance",
+			expected: []tagPolicyRule{
+				{Enforced: true, Key: "CostCenter", Required: true, Values: []string{"100", "200"}},
+				{Key: "Owner"},
+			},
+		},
+		"tag policy resource type": {
+			resourceType: "ec2:instance",
+			expected: []tagPolicyRule{
+				{Enforced: true, Key: "CostCenter", Required: true, Values: []string{"100", "200"}},
+				{Key: "Owner"},
+			},
+		},
+		"other resource type": {
+			resourceType: "s3:bucket",
+			expected: []tagPolicyRule{
+				{Enforced: true, Key: "CostCenter", Values: []string{"100", "200"}},
+				{Enforced: true, Key: "Owner"},
+			},
+		},
+		"unsupported terraform resource type": {
+			resourceType:  "aws_organizations_organization",
+			expectedError: true,
+		},
+		"unsupported tag policy resource type": {
+			resourceType:  "ec2:unknown",
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resourceType, err := tagPolicyTerraformResourceType(testCase.resourceType)
+
+			if got, want := err != nil, testCase.expectedError; got != want {
+				t.Fatalf("tagPolicyTerraformResourceType(%q) err %t, want %t: %v", testCase.resourceType, got, want, err)
+			}
+			if err != nil {
+				return
+			}
+
+			if diff := cmp.Diff(flattenTagPolicyRules(rules, resourceType), testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
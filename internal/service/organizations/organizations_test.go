@@ -91,6 +91,9 @@ func TestAccOrganizations_serial(t *testing.T) {
 			acctest.CtDisappears: testAccPolicyAttachment_disappears,
 			"Identity":           testAccOrganizationsPolicyAttachment_IdentitySerial,
 		},
+		"EffectiveTagPolicyDataSource": {
+			acctest.CtBasic: testAccEffectiveTagPolicyDataSource_basic,
+		},
 		"PolicyDataSource": {
 			"UnattachedPolicy": testAccPolicyDataSource_UnattachedPolicy,
 		},
@@ -17,7 +17,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_pinpointsmsvoicev2_phone_number", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_pinpointsmsvoicev2_phone_number", &resource.Sweeper{
 		Name: "aws_pinpointsmsvoicev2_phone_number",
 		F:    sweepPhoneNumbers,
 	})
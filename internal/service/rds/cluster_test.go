@@ -32,11 +32,13 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tfrds "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func init() {
 	acctest.RegisterServiceErrorCheckFunc(names.RDSServiceID, testAccErrorCheckSkip)
+	vcr.RegisterRedactionRules(names.RDSServiceID, "$..MasterUserPassword")
 }
 
 func testAccClusterImportStep(n string) resource.TestStep {
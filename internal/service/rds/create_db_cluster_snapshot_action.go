@@ -0,0 +1,188 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// createDBClusterSnapshotPollInterval defines polling cadence for the create DB cluster snapshot action.
+const createDBClusterSnapshotPollInterval = 15 * time.Second
+
+// @Action(aws_rds_create_db_cluster_snapshot, name="Create DB Cluster Snapshot")
+func newCreateDBClusterSnapshotAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &createDBClusterSnapshotAction{}, nil
+}
+
+var (
+	_ action.Action = (*createDBClusterSnapshotAction)(nil)
+)
+
+type createDBClusterSnapshotAction struct {
+	framework.ActionWithModel[createDBClusterSnapshotModel]
+}
+
+type createDBClusterSnapshotModel struct {
+	framework.WithRegionModel
+	DBClusterIdentifier         types.String `tfsdk:"db_cluster_identifier"`
+	DBClusterSnapshotIdentifier types.String `tfsdk:"db_cluster_snapshot_identifier"`
+	Tags                        tftags.Map   `tfsdk:"tags"`
+	Timeout                     types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *createDBClusterSnapshotAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a snapshot of an RDS DB cluster and waits for it to become available.",
+		Attributes: map[string]schema.Attribute{
+			"db_cluster_identifier": schema.StringAttribute{
+				Description: "Identifier of the DB cluster to snapshot",
+				Required:    true,
+			},
+			"db_cluster_snapshot_identifier": schema.StringAttribute{
+				Description: "Identifier of the DB cluster snapshot to create",
+				Required:    true,
+			},
+			names.AttrTags: schema.MapAttribute{
+				CustomType:  tftags.MapType,
+				ElementType: types.StringType,
+				Description: "Tags to assign to the DB cluster snapshot",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the DB cluster snapshot to become available (default: 3600)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(86400),
+				},
+			},
+		},
+	}
+}
+
+func (a *createDBClusterSnapshotAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config createDBClusterSnapshotModel
+
+	// Parse configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get AWS client
+	conn := a.Meta().RDSClient(ctx)
+
+	clusterID := config.DBClusterIdentifier.ValueString()
+	snapshotID := config.DBClusterSnapshotIdentifier.ValueString()
+	tags := a.Meta().DefaultTagsConfig(ctx).MergeTags(tftags.New(ctx, config.Tags)).IgnoreAWS()
+
+	// Set default timeout if not provided
+	timeout := 3600 * time.Second
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting RDS create DB cluster snapshot action", map[string]any{
+		"db_cluster_identifier":          clusterID,
+		"db_cluster_snapshot_identifier": snapshotID,
+		names.AttrTimeout:                timeout.String(),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Creating DB cluster snapshot %s of RDS DB cluster %s...", snapshotID, clusterID),
+	})
+
+	input := rds.CreateDBClusterSnapshotInput{
+		DBClusterIdentifier:         aws.String(clusterID),
+		DBClusterSnapshotIdentifier: aws.String(snapshotID),
+		Tags:                        svcTags(tags),
+	}
+
+	_, err := conn.CreateDBClusterSnapshot(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Create DB Cluster Snapshot",
+			fmt.Sprintf("Could not create DB cluster snapshot %s of RDS DB cluster %s: %s", snapshotID, clusterID, err),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("DB cluster snapshot %s creation started, waiting for it to become available...", snapshotID),
+	})
+
+	_, err = actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[int32], error) {
+		snapshot, err := findDBClusterSnapshotByID(ctx, conn, snapshotID)
+		if retry.NotFound(err) {
+			// The snapshot may not be visible immediately after it is created.
+			return actionwait.FetchResult[int32]{Status: clusterSnapshotStatusCreating}, nil
+		}
+		if err != nil {
+			return actionwait.FetchResult[int32]{}, fmt.Errorf("describing DB cluster snapshot: %w", err)
+		}
+		return actionwait.FetchResult[int32]{Status: actionwait.Status(aws.ToString(snapshot.Status)), Value: aws.ToInt32(snapshot.PercentProgress)}, nil
+	}, actionwait.Options[int32]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(createDBClusterSnapshotPollInterval),
+		ProgressInterval: 30 * time.Second,
+		SuccessStates:    []actionwait.Status{clusterSnapshotStatusAvailable},
+		TransitionalStates: []actionwait.Status{
+			clusterSnapshotStatusCreating,
+			clusterSnapshotStatusCopying,
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("DB cluster snapshot %s is %s (%d%% progress), continuing to wait for %s...", snapshotID, fr.Status, fr.Value, clusterSnapshotStatusAvailable),
+			})
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for DB Cluster Snapshot",
+				fmt.Sprintf("DB cluster snapshot %s did not become available within %s: %s", snapshotID, timeout, err),
+			)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected DB Cluster Snapshot State",
+				fmt.Sprintf("DB cluster snapshot %s entered unexpected state: %s", snapshotID, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for DB Cluster Snapshot",
+				fmt.Sprintf("Error while waiting for DB cluster snapshot %s: %s", snapshotID, err),
+			)
+		}
+		return
+	}
+
+	// Final success message
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("DB cluster snapshot %s of RDS DB cluster %s is available", snapshotID, clusterID),
+	})
+
+	tflog.Info(ctx, "RDS create DB cluster snapshot action completed successfully", map[string]any{
+		"db_cluster_identifier":          clusterID,
+		"db_cluster_snapshot_identifier": snapshotID,
+	})
+}
@@ -0,0 +1,148 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package rds_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfrds "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRDSCreateDBClusterSnapshotAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCreateDBClusterSnapshotActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCreateDBClusterSnapshotActionSnapshot(ctx, rName, nil),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSCreateDBClusterSnapshotAction_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCreateDBClusterSnapshotActionConfig_tags(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCreateDBClusterSnapshotActionSnapshot(ctx, rName, map[string]string{acctest.CtKey1: acctest.CtValue1}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSCreateDBClusterSnapshotAction_nonExistentCluster(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCreateDBClusterSnapshotActionConfig_nonExistentCluster(rName),
+				ExpectError: regexache.MustCompile(`DBClusterNotFoundFault`),
+			},
+		},
+	})
+}
+
+func testAccCheckCreateDBClusterSnapshotActionSnapshot(ctx context.Context, id string, wantTags map[string]string) resource.TestCheckFunc {
+	return testAccCheckSnapshotActionSnapshot(ctx, "DB Cluster Snapshot", id, wantTags, func(ctx context.Context, conn *rds.Client) (*string, []awstypes.Tag, error) {
+		output, err := tfrds.FindDBClusterSnapshotByID(ctx, conn, id)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return output.Status, output.TagList, nil
+	})
+}
+
+func testAccCreateDBClusterSnapshotActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccClusterSnapshotConfig_base(rName),
+		fmt.Sprintf(`
+action "aws_rds_create_db_cluster_snapshot" "test" {
+  config {
+    db_cluster_identifier          = aws_rds_cluster.test.cluster_identifier
+    db_cluster_snapshot_identifier = %[1]q
+  }
+}
+`, rName),
+		testAccActionConfig_trigger("aws_rds_create_db_cluster_snapshot", "aws_rds_cluster.test"))
+}
+
+func testAccCreateDBClusterSnapshotActionConfig_tags(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(
+		testAccClusterSnapshotConfig_base(rName),
+		fmt.Sprintf(`
+action "aws_rds_create_db_cluster_snapshot" "test" {
+  config {
+    db_cluster_identifier          = aws_rds_cluster.test.cluster_identifier
+    db_cluster_snapshot_identifier = %[1]q
+
+    tags = {
+      %[2]q = %[3]q
+    }
+  }
+}
+`, rName, tagKey1, tagValue1),
+		testAccActionConfig_trigger("aws_rds_create_db_cluster_snapshot", "aws_rds_cluster.test"))
+}
+
+func testAccCreateDBClusterSnapshotActionConfig_nonExistentCluster(rName string) string {
+	return acctest.ConfigCompose(
+		fmt.Sprintf(`
+action "aws_rds_create_db_cluster_snapshot" "test" {
+  config {
+    db_cluster_identifier          = %[1]q
+    db_cluster_snapshot_identifier = %[1]q
+  }
+}
+`, rName),
+		testAccActionConfig_trigger("aws_rds_create_db_cluster_snapshot", ""))
+}
@@ -0,0 +1,195 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package rds_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfrds "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRDSCreateDBSnapshotAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCreateDBSnapshotActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCreateDBSnapshotActionSnapshot(ctx, rName, nil),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSCreateDBSnapshotAction_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCreateDBSnapshotActionConfig_tags(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCreateDBSnapshotActionSnapshot(ctx, rName, map[string]string{acctest.CtKey1: acctest.CtValue1}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSCreateDBSnapshotAction_nonExistentInstance(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCreateDBSnapshotActionConfig_nonExistentInstance(rName),
+				ExpectError: regexache.MustCompile(`DBInstanceNotFound`),
+			},
+		},
+	})
+}
+
+func testAccCheckCreateDBSnapshotActionSnapshot(ctx context.Context, id string, wantTags map[string]string) resource.TestCheckFunc {
+	return testAccCheckSnapshotActionSnapshot(ctx, "DB Snapshot", id, wantTags, func(ctx context.Context, conn *rds.Client) (*string, []awstypes.Tag, error) {
+		output, err := tfrds.FindDBSnapshotByID(ctx, conn, id)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return output.Status, output.TagList, nil
+	})
+}
+
+// testAccCheckSnapshotActionSnapshot checks that a snapshot created by an RDS snapshot action is available and has the expected tags.
+func testAccCheckSnapshotActionSnapshot(ctx context.Context, kind, id string, wantTags map[string]string, find func(context.Context, *rds.Client) (*string, []awstypes.Tag, error)) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSClient(ctx)
+
+		status, tags, err := find(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		if got, want := aws.ToString(status), "available"; got != want {
+			return fmt.Errorf("RDS %s (%s) status is %s, expected %s", kind, id, got, want)
+		}
+
+		for k, v := range wantTags {
+			if !slices.ContainsFunc(tags, func(tag awstypes.Tag) bool {
+				return aws.ToString(tag.Key) == k && aws.ToString(tag.Value) == v
+			}) {
+				return fmt.Errorf("RDS %s (%s) is missing tag %s=%s", kind, id, k, v)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCreateDBSnapshotActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccSnapshotConfig_base(rName),
+		fmt.Sprintf(`
+action "aws_rds_create_db_snapshot" "test" {
+  config {
+    db_instance_identifier = aws_db_instance.test.identifier
+    db_snapshot_identifier = %[1]q
+  }
+}
+`, rName),
+		testAccActionConfig_trigger("aws_rds_create_db_snapshot", "aws_db_instance.test"))
+}
+
+func testAccCreateDBSnapshotActionConfig_tags(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(
+		testAccSnapshotConfig_base(rName),
+		fmt.Sprintf(`
+action "aws_rds_create_db_snapshot" "test" {
+  config {
+    db_instance_identifier = aws_db_instance.test.identifier
+    db_snapshot_identifier = %[1]q
+
+    tags = {
+      %[2]q = %[3]q
+    }
+  }
+}
+`, rName, tagKey1, tagValue1),
+		testAccActionConfig_trigger("aws_rds_create_db_snapshot", "aws_db_instance.test"))
+}
+
+func testAccCreateDBSnapshotActionConfig_nonExistentInstance(rName string) string {
+	return acctest.ConfigCompose(
+		fmt.Sprintf(`
+action "aws_rds_create_db_snapshot" "test" {
+  config {
+    db_instance_identifier = %[1]q
+    db_snapshot_identifier = %[1]q
+  }
+}
+`, rName),
+		testAccActionConfig_trigger("aws_rds_create_db_snapshot", ""))
+}
+
+// testAccActionConfig_trigger invokes the "test" action of the given type before terraform_data is created or updated.
+func testAccActionConfig_trigger(actionType, dependsOn string) string {
+	return fmt.Sprintf(`
+resource "terraform_data" "trigger" {
+  input = "trigger"
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.%[1]s.test]
+    }
+  }
+
+  depends_on = [%[2]s]
+}
+`, actionType, dependsOn)
+}
@@ -0,0 +1,266 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// failoverDBClusterPollInterval defines polling cadence for the failover DB cluster action.
+const failoverDBClusterPollInterval = 10 * time.Second
+
+// @Action(aws_rds_failover_db_cluster, name="Failover DB Cluster")
+func newFailoverDBClusterAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &failoverDBClusterAction{}, nil
+}
+
+var (
+	_ action.Action = (*failoverDBClusterAction)(nil)
+)
+
+type failoverDBClusterAction struct {
+	framework.ActionWithModel[failoverDBClusterModel]
+}
+
+type failoverDBClusterModel struct {
+	framework.WithRegionModel
+	DBClusterIdentifier        types.String `tfsdk:"db_cluster_identifier"`
+	TargetDBInstanceIdentifier types.String `tfsdk:"target_db_instance_identifier"`
+	Timeout                    types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *failoverDBClusterAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Forces a failover of an RDS DB cluster and waits for a new writer to become available.",
+		Attributes: map[string]schema.Attribute{
+			"db_cluster_identifier": schema.StringAttribute{
+				Description: "Identifier of the DB cluster to fail over",
+				Required:    true,
+			},
+			"target_db_instance_identifier": schema.StringAttribute{
+				Description: "Identifier of the DB instance to promote to the writer",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the failover to complete (default: 1800)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(7200),
+				},
+			},
+		},
+	}
+}
+
+func (a *failoverDBClusterAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config failoverDBClusterModel
+
+	// Parse configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get AWS client
+	conn := a.Meta().RDSClient(ctx)
+
+	clusterID := config.DBClusterIdentifier.ValueString()
+	targetID := config.TargetDBInstanceIdentifier.ValueString()
+
+	// Set default timeout if not provided
+	timeout := 1800 * time.Second
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting RDS failover DB cluster action", map[string]any{
+		"db_cluster_identifier":         clusterID,
+		"target_db_instance_identifier": targetID,
+		names.AttrTimeout:               timeout.String(),
+	})
+
+	cluster, err := findDBClusterByID(ctx, conn, clusterID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Describe DB Cluster",
+			fmt.Sprintf("Could not describe RDS DB cluster %s: %s", clusterID, err),
+		)
+		return
+	}
+
+	if status := aws.ToString(cluster.Status); status != clusterStatusAvailable {
+		resp.Diagnostics.AddError(
+			"Cannot Fail Over DB Cluster",
+			fmt.Sprintf("RDS DB cluster %s is in state '%s' and cannot be failed over. DB cluster must be in '%s' state.", clusterID, status, clusterStatusAvailable),
+		)
+		return
+	}
+
+	if err := validateDBClusterFailoverTarget(cluster.DBClusterMembers, targetID); err != nil {
+		resp.Diagnostics.AddError(
+			"Cannot Fail Over DB Cluster",
+			fmt.Sprintf("RDS DB cluster %s cannot be failed over: %s", clusterID, err),
+		)
+		return
+	}
+
+	writerID := dbClusterWriter(cluster.DBClusterMembers)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Failing over RDS DB cluster %s from writer %s (%s)...", clusterID, writerID, dbClusterMemberRoles(cluster.DBClusterMembers)),
+	})
+
+	input := rds.FailoverDBClusterInput{
+		DBClusterIdentifier: aws.String(clusterID),
+	}
+	if targetID != "" {
+		input.TargetDBInstanceIdentifier = aws.String(targetID)
+	}
+
+	_, err = conn.FailoverDBCluster(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Fail Over DB Cluster",
+			fmt.Sprintf("Could not fail over RDS DB cluster %s: %s", clusterID, err),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Failover started for RDS DB cluster %s, waiting for the writer to change...", clusterID),
+	})
+
+	// The cluster can report "available" before the failover has begun, so
+	// it is only considered complete once the writer has also changed.
+	fr, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.DBCluster], error) {
+		cluster, err := findDBClusterByID(ctx, conn, clusterID)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.DBCluster]{}, fmt.Errorf("describing DB cluster: %w", err)
+		}
+
+		status := aws.ToString(cluster.Status)
+		if status == clusterStatusAvailable {
+			if writer := dbClusterWriter(cluster.DBClusterMembers); writer == "" || writer == writerID || (targetID != "" && writer != targetID) {
+				status = clusterStatusAvailableWithUnchangedWriter
+			}
+		}
+
+		return actionwait.FetchResult[*awstypes.DBCluster]{Status: actionwait.Status(status), Value: cluster}, nil
+	}, actionwait.Options[*awstypes.DBCluster]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(failoverDBClusterPollInterval),
+		ProgressInterval: 30 * time.Second,
+		SuccessStates:    []actionwait.Status{clusterStatusAvailable},
+		TransitionalStates: []actionwait.Status{
+			clusterStatusAvailableWithUnchangedWriter,
+			clusterStatusFailingOver,
+			clusterStatusModifying,
+			clusterStatusRebooting,
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			if cluster, ok := fr.Value.(*awstypes.DBCluster); ok && cluster != nil {
+				resp.SendProgress(action.InvokeProgressEvent{
+					Message: fmt.Sprintf("RDS DB cluster %s is %s: %s", clusterID, aws.ToString(cluster.Status), dbClusterMemberRoles(cluster.DBClusterMembers)),
+				})
+			}
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for DB Cluster Failover",
+				fmt.Sprintf("RDS DB cluster %s did not fail over within %s: %s", clusterID, timeout, err),
+			)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected DB Cluster State",
+				fmt.Sprintf("RDS DB cluster %s entered unexpected state while failing over: %s", clusterID, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for DB Cluster Failover",
+				fmt.Sprintf("Error while waiting for RDS DB cluster %s to fail over: %s", clusterID, err),
+			)
+		}
+		return
+	}
+
+	newWriterID := dbClusterWriter(fr.Value.DBClusterMembers)
+
+	// Final success message
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("RDS DB cluster %s failed over from %s to %s", clusterID, writerID, newWriterID),
+	})
+
+	tflog.Info(ctx, "RDS failover DB cluster action completed successfully", map[string]any{
+		"db_cluster_identifier": clusterID,
+		"previous_writer":       writerID,
+		"writer":                newWriterID,
+	})
+}
+
+// validateDBClusterFailoverTarget checks that the cluster has a reader to fail over to and,
+// if a target is given, that it is one of the cluster's readers.
+func validateDBClusterFailoverTarget(members []awstypes.DBClusterMember, targetID string) error {
+	var readers []string
+	for _, member := range members {
+		if !aws.ToBool(member.IsClusterWriter) {
+			readers = append(readers, aws.ToString(member.DBInstanceIdentifier))
+		}
+	}
+
+	if len(readers) == 0 {
+		return errors.New("DB cluster has no reader instances")
+	}
+
+	if targetID != "" && !slices.Contains(readers, targetID) {
+		return fmt.Errorf("target DB instance %s is not a reader in the DB cluster (%s)", targetID, dbClusterMemberRoles(members))
+	}
+
+	return nil
+}
+
+// dbClusterWriter returns the identifier of the cluster's writer instance.
+func dbClusterWriter(members []awstypes.DBClusterMember) string {
+	for _, member := range members {
+		if aws.ToBool(member.IsClusterWriter) {
+			return aws.ToString(member.DBInstanceIdentifier)
+		}
+	}
+	return ""
+}
+
+// dbClusterMemberRoles describes the role of each cluster member, e.g. "db-1 (writer), db-2 (reader)".
+func dbClusterMemberRoles(members []awstypes.DBClusterMember) string {
+	roles := make([]string, 0, len(members))
+	for _, member := range members {
+		role := "reader"
+		if aws.ToBool(member.IsClusterWriter) {
+			role = "writer"
+		}
+		roles = append(roles, fmt.Sprintf("%s (%s)", aws.ToString(member.DBInstanceIdentifier), role))
+	}
+	return strings.Join(roles, ", ")
+}
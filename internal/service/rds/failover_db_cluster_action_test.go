@@ -0,0 +1,159 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package rds_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfrds "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRDSFailoverDBClusterAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var dbCluster types.DBCluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFailoverDBClusterActionConfig_base(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &dbCluster),
+				),
+			},
+			{
+				Config: testAccFailoverDBClusterActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFailoverDBClusterActionWriterChanged(ctx, resourceName, &dbCluster),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSFailoverDBClusterAction_noReader(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFailoverDBClusterActionConfig_noReader(rName),
+				ExpectError: regexache.MustCompile(`DB cluster has no reader instances`),
+			},
+		},
+	})
+}
+
+func testAccCheckFailoverDBClusterActionWriterChanged(ctx context.Context, n string, before *types.DBCluster) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSClient(ctx)
+
+		after, err := tfrds.FindDBClusterByID(ctx, conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		writer := func(members []types.DBClusterMember) string {
+			for _, member := range members {
+				if aws.ToBool(member.IsClusterWriter) {
+					return aws.ToString(member.DBInstanceIdentifier)
+				}
+			}
+			return ""
+		}
+
+		if b, a := writer(before.DBClusterMembers), writer(after.DBClusterMembers); b == a {
+			return fmt.Errorf("RDS Cluster (%s) writer %s did not change", rs.Primary.ID, a)
+		}
+
+		return nil
+	}
+}
+
+func testAccFailoverDBClusterActionConfig_base(rName string) string {
+	return acctest.ConfigCompose(
+		testAccClusterSnapshotConfig_base(rName),
+		fmt.Sprintf(`
+data "aws_rds_orderable_db_instance" "test" {
+  engine                     = aws_rds_cluster.test.engine
+  engine_version             = aws_rds_cluster.test.engine_version
+  preferred_instance_classes = ["db.t3.medium", "db.r5.large", "db.r6g.large"]
+}
+
+resource "aws_rds_cluster_instance" "test" {
+  count = 2
+
+  identifier         = "%[1]s-${count.index}"
+  cluster_identifier = aws_rds_cluster.test.id
+  engine             = aws_rds_cluster.test.engine
+  engine_version     = aws_rds_cluster.test.engine_version
+  instance_class     = data.aws_rds_orderable_db_instance.test.instance_class
+}
+`, rName))
+}
+
+func testAccFailoverDBClusterActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccFailoverDBClusterActionConfig_base(rName),
+		`
+action "aws_rds_failover_db_cluster" "test" {
+  config {
+    db_cluster_identifier = aws_rds_cluster.test.cluster_identifier
+  }
+}
+`,
+		testAccActionConfig_trigger("aws_rds_failover_db_cluster", "aws_rds_cluster_instance.test"))
+}
+
+func testAccFailoverDBClusterActionConfig_noReader(rName string) string {
+	return acctest.ConfigCompose(
+		testAccClusterSnapshotConfig_base(rName),
+		`
+action "aws_rds_failover_db_cluster" "test" {
+  config {
+    db_cluster_identifier = aws_rds_cluster.test.cluster_identifier
+  }
+}
+`,
+		testAccActionConfig_trigger("aws_rds_failover_db_cluster", "aws_rds_cluster.test"))
+}
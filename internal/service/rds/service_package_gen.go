@@ -20,6 +20,29 @@ import (
 
 type servicePackage struct{}
 
+func (p *servicePackage) Actions(ctx context.Context) []*inttypes.ServicePackageAction {
+	return []*inttypes.ServicePackageAction{
+		{
+			Factory:  newCreateDBClusterSnapshotAction,
+			TypeName: "aws_rds_create_db_cluster_snapshot",
+			Name:     "Create DB Cluster Snapshot",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+		{
+			Factory:  newCreateDBSnapshotAction,
+			TypeName: "aws_rds_create_db_snapshot",
+			Name:     "Create DB Snapshot",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+		{
+			Factory:  newFailoverDBClusterAction,
+			TypeName: "aws_rds_failover_db_cluster",
+			Name:     "Failover DB Cluster",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+	}
+}
+
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*inttypes.ServicePackageFrameworkDataSource {
 	return []*inttypes.ServicePackageFrameworkDataSource{
 		{
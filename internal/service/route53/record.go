@@ -15,6 +15,10 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/route53"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -22,12 +26,17 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -333,6 +342,14 @@ func resourceRecord() *schema.Resource {
 	}
 }
 
+// @SDKListResource("aws_route53_record")
+func recordResourceAsListResource() inttypes.ListResourceForSDK {
+	l := recordListResource{}
+	l.SetResourceSchema(resourceRecord())
+
+	return &l
+}
+
 func resourceRecordCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).Route53Client(ctx)
@@ -405,6 +422,14 @@ func resourceRecordRead(ctx context.Context, d *schema.ResourceData, meta any) d
 		return sdkdiag.AppendErrorf(diags, "reading Route 53 Record (%s): %s", d.Id(), err)
 	}
 
+	if err := resourceRecordFlatten(d, record, fqdn); err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route 53 Record (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceRecordFlatten(d *schema.ResourceData, record *awstypes.ResourceRecordSet, fqdn *string) error {
 	if alias := record.AliasTarget; alias != nil {
 		tfList := []any{map[string]any{
 			"evaluate_target_health": alias.EvaluateTargetHealth,
@@ -413,7 +438,7 @@ func resourceRecordRead(ctx context.Context, d *schema.ResourceData, meta any) d
 		}}
 
 		if err := d.Set(names.AttrAlias, tfList); err != nil {
-			return sdkdiag.AppendErrorf(diags, "setting alias: %s", err)
+			return fmt.Errorf("setting alias: %w", err)
 		}
 	}
 	if cidrRoutingConfig := record.CidrRoutingConfig; cidrRoutingConfig != nil {
@@ -423,7 +448,7 @@ func resourceRecordRead(ctx context.Context, d *schema.ResourceData, meta any) d
 		}}
 
 		if err := d.Set("cidr_routing_policy", tfList); err != nil {
-			return sdkdiag.AppendErrorf(diags, "setting cidr_routing_policy: %s", err)
+			return fmt.Errorf("setting cidr_routing_policy: %w", err)
 		}
 	}
 	if failover := record.Failover; failover != "" {
@@ -432,7 +457,7 @@ func resourceRecordRead(ctx context.Context, d *schema.ResourceData, meta any) d
 		}}
 
 		if err := d.Set("failover_routing_policy", tfList); err != nil {
-			return sdkdiag.AppendErrorf(diags, "setting failover_routing_policy: %s", err)
+			return fmt.Errorf("setting failover_routing_policy: %w", err)
 		}
 	}
 	// findResourceRecordSetByFourPartKey returns the FQDN in API-normalized form.
@@ -450,7 +475,7 @@ func resourceRecordRead(ctx context.Context, d *schema.ResourceData, meta any) d
 		}}
 
 		if err := d.Set("geolocation_routing_policy", tfList); err != nil {
-			return sdkdiag.AppendErrorf(diags, "setting geolocation_routing_policy: %s", err)
+			return fmt.Errorf("setting geolocation_routing_policy: %w", err)
 		}
 	}
 	if geoProximityLocation := record.GeoProximityLocation; geoProximityLocation != nil {
@@ -462,7 +487,7 @@ func resourceRecordRead(ctx context.Context, d *schema.ResourceData, meta any) d
 		}}
 
 		if err := d.Set("geoproximity_routing_policy", tfList); err != nil {
-			return sdkdiag.AppendErrorf(diags, "setting geoproximity_routing_policy: %s", err)
+			return fmt.Errorf("setting geoproximity_routing_policy: %w", err)
 		}
 	}
 	d.Set("health_check_id", record.HealthCheckId)
@@ -472,12 +497,12 @@ func resourceRecordRead(ctx context.Context, d *schema.ResourceData, meta any) d
 		}}
 
 		if err := d.Set("latency_routing_policy", tfList); err != nil {
-			return sdkdiag.AppendErrorf(diags, "setting latency_routing_policy: %s", err)
+			return fmt.Errorf("setting latency_routing_policy: %w", err)
 		}
 	}
 	d.Set("multivalue_answer_routing_policy", record.MultiValueAnswer)
 	if err := d.Set("records", flattenResourceRecords(record.ResourceRecords, record.Type)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting records: %s", err)
+		return fmt.Errorf("setting records: %w", err)
 	}
 
 	d.Set("set_identifier", record.SetIdentifier)
@@ -488,11 +513,11 @@ func resourceRecordRead(ctx context.Context, d *schema.ResourceData, meta any) d
 		}}
 
 		if err := d.Set("weighted_routing_policy", tfList); err != nil {
-			return sdkdiag.AppendErrorf(diags, "setting weighted_routing_policy: %s", err)
+			return fmt.Errorf("setting weighted_routing_policy: %w", err)
 		}
 	}
 
-	return diags
+	return nil
 }
 
 func resourceRecordUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -1165,3 +1190,144 @@ func createRecordImportID(d *schema.ResourceData) string {
 	}
 	return strings.Join(parts, "_")
 }
+
+var _ list.ListResourceWithRawV5Schemas = &recordListResource{}
+
+type recordListResource struct {
+	framework.ResourceWithConfigure
+	framework.ListResourceWithSDKv2Resource
+}
+
+type recordListResourceModel struct {
+	NameSuffix types.String                        `tfsdk:"name_suffix"`
+	Type       fwtypes.StringEnum[awstypes.RRType] `tfsdk:"type"`
+	ZoneID     types.String                        `tfsdk:"zone_id"`
+}
+
+func (l *recordListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"name_suffix": listschema.StringAttribute{
+				Description: "Domain name suffix. Only records with this name or a subdomain of it are listed.",
+				Optional:    true,
+			},
+			names.AttrType: listschema.StringAttribute{
+				CustomType:  fwtypes.StringEnumType[awstypes.RRType](),
+				Description: "Record type. Only records of this type are listed.",
+				Optional:    true,
+			},
+			"zone_id": listschema.StringAttribute{
+				Description: "ID of the Hosted Zone containing the records.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+func (l *recordListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.Route53Client(ctx)
+
+	var query recordListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	zoneID := cleanZoneID(query.ZoneID.ValueString())
+	input := route53.ListResourceRecordSetsInput{
+		HostedZoneId: aws.String(zoneID),
+	}
+
+	// Records are returned sorted by name with the labels reversed,
+	// so all records with the specified suffix are listed from the suffix itself onwards.
+	var nameSuffix string
+	if v := query.NameSuffix.ValueString(); v != "" {
+		nameSuffix = normalizeDomainName(v)
+		input.StartRecordName = aws.String(nameSuffix)
+	}
+
+	tflog.Info(ctx, "Listing resources", map[string]any{
+		logging.ResourceAttributeKey("zone_id"): zoneID,
+	})
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		pages := route53.NewListResourceRecordSetsPaginator(conn, &input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			for _, record := range page.ResourceRecordSets {
+				if nameSuffix != "" {
+					if v := normalizeDomainName(record.Name); v != nameSuffix && !strings.HasSuffix(v, "."+nameSuffix) {
+						continue
+					}
+				}
+
+				if v := query.Type.ValueEnum(); v != "" && record.Type != v {
+					continue
+				}
+
+				fqdn := recordListItemName(aws.ToString(record.Name))
+
+				ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrName), fqdn)
+				ctx = tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrType), string(record.Type))
+
+				result := request.NewListResult(ctx)
+
+				rd := l.ResourceData()
+				rd.Set("zone_id", zoneID)
+				rd.Set(names.AttrName, fqdn)
+				rd.Set(names.AttrType, string(record.Type))
+				rd.Set("set_identifier", record.SetIdentifier)
+				rd.SetId(createRecordImportID(rd))
+
+				if request.IncludeResource {
+					tflog.Info(ctx, "Reading resource")
+					if err := resourceRecordFlatten(rd, &record, aws.String(fqdn)); err != nil {
+						result = fwdiag.NewListResultErrorDiagnostic(err)
+						yield(result)
+						return
+					}
+				}
+
+				result.DisplayName = recordListItemDisplayName(fqdn, record)
+
+				l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+				if result.Diagnostics.HasError() {
+					yield(result)
+					return
+				}
+
+				if !yield(result) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// recordListItemName returns the name of a listed record in the same normalized form
+// as the name suffix filter, restoring any '*' as the leftmost label in the domain name as resourceRecordRead does.
+func recordListItemName(name string) string {
+	name = normalizeDomainName(name)
+	if v, ok := strings.CutPrefix(name, `\052.`); ok {
+		name = `*.` + v
+	}
+
+	return name
+}
+
+func recordListItemDisplayName(fqdn string, record awstypes.ResourceRecordSet) string {
+	if v := aws.ToString(record.SetIdentifier); v != "" {
+		return fmt.Sprintf("%s %s (%s)", fqdn, record.Type, v)
+	}
+
+	return fmt.Sprintf("%s %s", fqdn, record.Type)
+}
@@ -89,6 +89,29 @@ func TestExpandRecordName(t *testing.T) {
 	}
 }
 
+func TestRecordListItemName(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		Input, Output string
+	}{
+		{"www.example.com.", "www.example.com"},
+		{"WWW.Example.COM.", "www.example.com"},
+		{"\\052.example.com.", "*.example.com"},
+		{"\\052.www.example.com.", "*.www.example.com"},
+		{"www.\\052.example.com.", "www.\\052.example.com"},
+		{"\\100.example.com.", "\\100.example.com"},
+		{"example.com", "example.com"},
+	}
+
+	for _, tc := range cases {
+		actual := recordListItemName(tc.Input)
+		if actual != tc.Output {
+			t.Fatalf("input: %s\noutput: %s", tc.Input, actual)
+		}
+	}
+}
+
 func TestParseRecordID(t *testing.T) {
 	t.Parallel()
 
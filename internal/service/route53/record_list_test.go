@@ -0,0 +1,90 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package route53_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfknownvalue "github.com/hashicorp/terraform-provider-aws/internal/acctest/knownvalue"
+	tfstatecheck "github.com/hashicorp/terraform-provider-aws/internal/acctest/statecheck"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRoute53Record_List_Basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	zoneName := acctest.RandomDomain()
+	recordName := zoneName.RandomSubdomain()
+
+	zoneID := tfstatecheck.StateValue()
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, names.Route53ServiceID),
+		CheckDestroy: testAccCheckRecordDestroy(ctx),
+		Steps: []resource.TestStep{
+			// Step 1: Setup
+			{
+				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+				ConfigDirectory:          config.StaticDirectory("testdata/Record/list_basic/"),
+				ConfigVariables: config.Variables{
+					"recordName": config.StringVariable(recordName.String()),
+					"zoneName":   config.StringVariable(zoneName.String()),
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					zoneID.GetStateValue("aws_route53_zone.test", tfjsonpath.New("zone_id")),
+				},
+			},
+
+			// Step 2: Query
+			{
+				Query:                    true,
+				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+				ConfigDirectory:          config.StaticDirectory("testdata/Record/list_basic/"),
+				ConfigVariables: config.Variables{
+					"recordName": config.StringVariable(recordName.String()),
+					"zoneName":   config.StringVariable(zoneName.String()),
+				},
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectIdentity("aws_route53_record.test", map[string]knownvalue.Check{
+						names.AttrAccountID: tfknownvalue.AccountID(),
+						"zone_id":           zoneID.Value(),
+						names.AttrName:      knownvalue.StringExact("0." + recordName.String()),
+						names.AttrType:      knownvalue.StringExact("A"),
+						"set_identifier":    knownvalue.Null(),
+					}),
+
+					querycheck.ExpectIdentity("aws_route53_record.test", map[string]knownvalue.Check{
+						names.AttrAccountID: tfknownvalue.AccountID(),
+						"zone_id":           zoneID.Value(),
+						names.AttrName:      knownvalue.StringExact("1." + recordName.String()),
+						names.AttrType:      knownvalue.StringExact("A"),
+						"set_identifier":    knownvalue.Null(),
+					}),
+
+					querycheck.ExpectIdentity("aws_route53_record.test", map[string]knownvalue.Check{
+						names.AttrAccountID: tfknownvalue.AccountID(),
+						"zone_id":           zoneID.Value(),
+						names.AttrName:      knownvalue.StringExact("2." + recordName.String()),
+						names.AttrType:      knownvalue.StringExact("A"),
+						"set_identifier":    knownvalue.Null(),
+					}),
+
+					querycheck.ExpectLength("aws_route53_record.test", 3),
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,38 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+provider "aws" {}
+
+resource "aws_route53_record" "test" {
+  count = 3
+
+  zone_id = aws_route53_zone.test.zone_id
+  name    = "${count.index}.${var.recordName}"
+  type    = "A"
+  ttl     = "30"
+  records = ["127.0.0.1"]
+}
+
+resource "aws_route53_record" "other" {
+  zone_id = aws_route53_zone.test.zone_id
+  name    = var.recordName
+  type    = "TXT"
+  ttl     = "30"
+  records = ["other"]
+}
+
+resource "aws_route53_zone" "test" {
+  name = var.zoneName
+}
+
+variable "recordName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
+
+variable "zoneName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
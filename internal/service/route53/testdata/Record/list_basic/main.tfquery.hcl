@@ -0,0 +1,12 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+list "aws_route53_record" "test" {
+  provider = aws
+
+  config {
+    zone_id     = aws_route53_zone.test.zone_id
+    name_suffix = var.recordName
+    type        = "A"
+  }
+}
@@ -0,0 +1,12 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_route53_zone" "test" {
+  name = var.zoneName
+}
+
+variable "zoneName" {
+  type     = string
+  nullable = false
+}
+
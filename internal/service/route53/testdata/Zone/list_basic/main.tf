@@ -0,0 +1,16 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+provider "aws" {}
+
+resource "aws_route53_zone" "test" {
+  count = 3
+
+  name = "${count.index}.${var.zoneName}"
+}
+
+variable "zoneName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
@@ -0,0 +1,10 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+list "aws_route53_zone" "test" {
+  provider = aws
+
+  config {
+    private_zone = false
+  }
+}
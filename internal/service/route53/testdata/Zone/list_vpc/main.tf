@@ -0,0 +1,30 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+provider "aws" {}
+
+resource "aws_route53_zone" "test" {
+  count = 2
+
+  name = "${count.index}.${var.zoneName}"
+
+  vpc {
+    vpc_id = aws_vpc.test.id
+  }
+}
+
+resource "aws_route53_zone" "public" {
+  name = "public.${var.zoneName}"
+}
+
+resource "aws_vpc" "test" {
+  cidr_block           = "10.0.0.0/16"
+  enable_dns_hostnames = true
+  enable_dns_support   = true
+}
+
+variable "zoneName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
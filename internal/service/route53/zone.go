@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"iter"
 	"log"
 	"slices"
 	"time"
@@ -15,6 +16,10 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/route53"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/route53/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -22,17 +27,29 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // @SDKResource("aws_route53_zone", name="Hosted Zone")
 // @Tags(identifierAttribute="zone_id", resourceType="hostedzone")
+// @IdentityAttribute("zone_id")
+// @Testing(name="Zone")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/route53;route53.GetHostedZoneOutput")
+// @Testing(idAttrDuplicates="zone_id")
+// @Testing(domainTfVar="zoneName")
+// @Testing(importIgnore="force_destroy")
+// @Testing(generator=false)
+// @Testing(preIdentityVersion="v6.26.0")
 func resourceZone() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceZoneCreate,
@@ -40,10 +57,6 @@ func resourceZone() *schema.Resource {
 		UpdateWithoutTimeout: resourceZoneUpdate,
 		DeleteWithoutTimeout: resourceZoneDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -129,6 +142,14 @@ func resourceZone() *schema.Resource {
 	}
 }
 
+// @SDKListResource("aws_route53_zone")
+func zoneResourceAsListResource() inttypes.ListResourceForSDK {
+	l := zoneListResource{}
+	l.SetResourceSchema(resourceZone())
+
+	return &l
+}
+
 func resourceZoneCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).Route53Client(ctx)
@@ -206,8 +227,16 @@ func resourceZoneRead(ctx context.Context, d *schema.ResourceData, meta any) dia
 		return sdkdiag.AppendErrorf(diags, "reading Route53 Hosted Zone (%s): %s", d.Id(), err)
 	}
 
+	if err := resourceZoneFlatten(ctx, meta.(*conns.AWSClient), d, output); err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route53 Hosted Zone (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceZoneFlatten(ctx context.Context, c *conns.AWSClient, d *schema.ResourceData, output *route53.GetHostedZoneOutput) error {
 	zoneID := cleanZoneID(aws.ToString(output.HostedZone.Id))
-	d.Set(names.AttrARN, zoneARN(ctx, meta.(*conns.AWSClient), zoneID))
+	d.Set(names.AttrARN, zoneARN(ctx, c, zoneID))
 	d.Set(names.AttrComment, "")
 	d.Set("delegation_set_id", "")
 	if v := output.HostedZone.Features; v != nil {
@@ -232,10 +261,11 @@ func resourceZoneRead(ctx context.Context, d *schema.ResourceData, meta any) dia
 		d.Set(names.AttrComment, output.HostedZone.Config.Comment)
 
 		if output.HostedZone.Config.PrivateZone {
-			nameServers, err = findNameServersByZone(ctx, conn, d.Id(), d.Get(names.AttrName).(string))
+			var err error
+			nameServers, err = findNameServersByZone(ctx, c.Route53Client(ctx), zoneID, d.Get(names.AttrName).(string))
 
 			if err != nil {
-				return sdkdiag.AppendErrorf(diags, "reading Route53 Hosted Zone (%s) name servers: %s", d.Id(), err)
+				return fmt.Errorf("reading name servers: %w", err)
 			}
 		}
 	}
@@ -244,10 +274,10 @@ func resourceZoneRead(ctx context.Context, d *schema.ResourceData, meta any) dia
 	slices.Sort(nameServers)
 	d.Set("name_servers", nameServers)
 	if err := d.Set("vpc", flattenVPCs(output.VPCs)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting vpc: %s", err)
+		return fmt.Errorf("setting vpc: %w", err)
 	}
 
-	return diags
+	return nil
 }
 
 func resourceZoneUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -614,3 +644,190 @@ func findPublicHostedZoneIDByDomainName(ctx context.Context, conn *route53.Clien
 func zoneARN(ctx context.Context, c *conns.AWSClient, id string) string {
 	return c.GlobalARNNoAccount(ctx, "route53", "hostedzone/"+id)
 }
+
+var _ list.ListResourceWithRawV5Schemas = &zoneListResource{}
+
+type zoneListResource struct {
+	framework.ResourceWithConfigure
+	framework.ListResourceWithSDKv2Resource
+	framework.ListResourceWithSDKv2Tags
+}
+
+type zoneListResourceModel struct {
+	PrivateZone types.Bool   `tfsdk:"private_zone"`
+	VPCID       types.String `tfsdk:"vpc_id"`
+	VPCRegion   types.String `tfsdk:"vpc_region"`
+}
+
+func (l *zoneListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"private_zone": listschema.BoolAttribute{
+				Description: "Whether to list only private (`true`) or only public (`false`) Hosted Zones.",
+				Optional:    true,
+			},
+			names.AttrVPCID: listschema.StringAttribute{
+				Description: "ID of a VPC. Only private Hosted Zones associated with the VPC are listed.",
+				Optional:    true,
+			},
+			"vpc_region": listschema.StringAttribute{
+				Description: "Region of the VPC. Defaults to the Region set in the provider configuration.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (l *zoneListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.Route53Client(ctx)
+
+	var query zoneListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	var zones iter.Seq2[zoneListItem, error]
+	if vpcID := query.VPCID.ValueString(); vpcID != "" {
+		if !query.PrivateZone.IsNull() && !query.PrivateZone.ValueBool() {
+			// Only private Hosted Zones are associated with VPCs.
+			stream.Results = list.NoListResults
+			return
+		}
+
+		vpcRegion := query.VPCRegion.ValueString()
+		if vpcRegion == "" {
+			vpcRegion = awsClient.Region(ctx)
+		}
+		input := route53.ListHostedZonesByVPCInput{
+			VPCId:     aws.String(vpcID),
+			VPCRegion: awstypes.VPCRegion(vpcRegion),
+		}
+		zones = listHostedZonesByVPC(ctx, conn, &input)
+	} else {
+		var input route53.ListHostedZonesInput
+		if query.PrivateZone.ValueBool() {
+			input.HostedZoneType = awstypes.HostedZoneTypePrivateHostedZone
+		}
+		zones = listHostedZones(ctx, conn, &input, func(v *awstypes.HostedZone) bool {
+			if query.PrivateZone.IsNull() {
+				return true
+			}
+			return v.Config != nil && v.Config.PrivateZone == query.PrivateZone.ValueBool()
+		})
+	}
+
+	tflog.Info(ctx, "Listing resources")
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		for zone, err := range zones {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey("zone_id"), zone.id)
+
+			result := request.NewListResult(ctx)
+
+			rd := l.ResourceData()
+			rd.SetId(zone.id)
+			rd.Set("zone_id", zone.id)
+			rd.Set(names.AttrName, zone.name)
+
+			if request.IncludeResource {
+				output, err := findHostedZoneByID(ctx, conn, zone.id)
+				if err != nil {
+					result = fwdiag.NewListResultErrorDiagnostic(err)
+					yield(result)
+					return
+				}
+
+				tflog.Info(ctx, "Reading resource")
+				if err := resourceZoneFlatten(ctx, awsClient, rd, output); err != nil {
+					result = fwdiag.NewListResultErrorDiagnostic(err)
+					yield(result)
+					return
+				}
+			}
+
+			// set tags
+			err = l.SetTags(ctx, awsClient, rd)
+			if err != nil {
+				result = fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			result.DisplayName = fmt.Sprintf("%s (%s)", zone.name, zone.id)
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+// zoneListItem is the ID and name of a listed Hosted Zone.
+type zoneListItem struct {
+	id   string
+	name string
+}
+
+func listHostedZones(ctx context.Context, conn *route53.Client, input *route53.ListHostedZonesInput, filter tfslices.Predicate[*awstypes.HostedZone]) iter.Seq2[zoneListItem, error] {
+	return func(yield func(zoneListItem, error) bool) {
+		pages := route53.NewListHostedZonesPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(zoneListItem{}, err)
+				return
+			}
+
+			for _, v := range page.HostedZones {
+				if !filter(&v) {
+					continue
+				}
+
+				item := zoneListItem{
+					id:   cleanZoneID(aws.ToString(v.Id)),
+					name: normalizeDomainName(aws.ToString(v.Name)),
+				}
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func listHostedZonesByVPC(ctx context.Context, conn *route53.Client, input *route53.ListHostedZonesByVPCInput) iter.Seq2[zoneListItem, error] {
+	return func(yield func(zoneListItem, error) bool) {
+		err := listHostedZonesByVPCPages(ctx, conn, input, func(page *route53.ListHostedZonesByVPCOutput, lastPage bool) bool {
+			for _, v := range page.HostedZoneSummaries {
+				item := zoneListItem{
+					id:   cleanZoneID(aws.ToString(v.HostedZoneId)),
+					name: normalizeDomainName(aws.ToString(v.Name)),
+				}
+				if !yield(item, nil) {
+					return false
+				}
+			}
+			return !lastPage
+		})
+
+		if err != nil {
+			yield(zoneListItem{}, err)
+		}
+	}
+}
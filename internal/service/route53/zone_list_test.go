@@ -0,0 +1,140 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package route53_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfknownvalue "github.com/hashicorp/terraform-provider-aws/internal/acctest/knownvalue"
+	tfstatecheck "github.com/hashicorp/terraform-provider-aws/internal/acctest/statecheck"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRoute53Zone_List_Basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resourceName1 := "aws_route53_zone.test[0]"
+	resourceName2 := "aws_route53_zone.test[1]"
+	resourceName3 := "aws_route53_zone.test[2]"
+	zoneName := acctest.RandomDomainName()
+
+	id1 := tfstatecheck.StateValue()
+	id2 := tfstatecheck.StateValue()
+	id3 := tfstatecheck.StateValue()
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, names.Route53ServiceID),
+		CheckDestroy: testAccCheckZoneDestroy(ctx),
+		Steps: []resource.TestStep{
+			// Step 1: Setup
+			{
+				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+				ConfigDirectory:          config.StaticDirectory("testdata/Zone/list_basic/"),
+				ConfigVariables: config.Variables{
+					"zoneName": config.StringVariable(zoneName),
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					id1.GetStateValue(resourceName1, tfjsonpath.New("zone_id")),
+					id2.GetStateValue(resourceName2, tfjsonpath.New("zone_id")),
+					id3.GetStateValue(resourceName3, tfjsonpath.New("zone_id")),
+				},
+			},
+
+			// Step 2: Query
+			{
+				Query:                    true,
+				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+				ConfigDirectory:          config.StaticDirectory("testdata/Zone/list_basic/"),
+				ConfigVariables: config.Variables{
+					"zoneName": config.StringVariable(zoneName),
+				},
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectIdentity("aws_route53_zone.test", map[string]knownvalue.Check{
+						names.AttrAccountID: tfknownvalue.AccountID(),
+						"zone_id":           id1.Value(),
+					}),
+
+					querycheck.ExpectIdentity("aws_route53_zone.test", map[string]knownvalue.Check{
+						names.AttrAccountID: tfknownvalue.AccountID(),
+						"zone_id":           id2.Value(),
+					}),
+
+					querycheck.ExpectIdentity("aws_route53_zone.test", map[string]knownvalue.Check{
+						names.AttrAccountID: tfknownvalue.AccountID(),
+						"zone_id":           id3.Value(),
+					}),
+				},
+			},
+		},
+	})
+}
+
+func TestAccRoute53Zone_List_VPC(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resourceName1 := "aws_route53_zone.test[0]"
+	resourceName2 := "aws_route53_zone.test[1]"
+	zoneName := acctest.RandomDomainName()
+
+	id1 := tfstatecheck.StateValue()
+	id2 := tfstatecheck.StateValue()
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, names.Route53ServiceID),
+		CheckDestroy: testAccCheckZoneDestroy(ctx),
+		Steps: []resource.TestStep{
+			// Step 1: Setup
+			{
+				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+				ConfigDirectory:          config.StaticDirectory("testdata/Zone/list_vpc/"),
+				ConfigVariables: config.Variables{
+					"zoneName": config.StringVariable(zoneName),
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					id1.GetStateValue(resourceName1, tfjsonpath.New("zone_id")),
+					id2.GetStateValue(resourceName2, tfjsonpath.New("zone_id")),
+				},
+			},
+
+			// Step 2: Query
+			{
+				Query:                    true,
+				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+				ConfigDirectory:          config.StaticDirectory("testdata/Zone/list_vpc/"),
+				ConfigVariables: config.Variables{
+					"zoneName": config.StringVariable(zoneName),
+				},
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectIdentity("aws_route53_zone.test", map[string]knownvalue.Check{
+						names.AttrAccountID: tfknownvalue.AccountID(),
+						"zone_id":           id1.Value(),
+					}),
+
+					querycheck.ExpectIdentity("aws_route53_zone.test", map[string]knownvalue.Check{
+						names.AttrAccountID: tfknownvalue.AccountID(),
+						"zone_id":           id2.Value(),
+					}),
+
+					querycheck.ExpectLength("aws_route53_zone.test", 2),
+				},
+			},
+		},
+	})
+}
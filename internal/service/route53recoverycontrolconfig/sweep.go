@@ -16,7 +16,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_route53recoverycontrolconfig_cluster", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_route53recoverycontrolconfig_cluster", &resource.Sweeper{
 		Name: "aws_route53recoverycontrolconfig_cluster",
 		F:    sweepClusters,
 		Dependencies: []string{
@@ -24,7 +24,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_route53recoverycontrolconfig_control_panel", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_route53recoverycontrolconfig_control_panel", &resource.Sweeper{
 		Name: "aws_route53recoverycontrolconfig_control_panel",
 		F:    sweepControlPanels,
 		Dependencies: []string{
@@ -33,12 +33,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_route53recoverycontrolconfig_routing_control", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_route53recoverycontrolconfig_routing_control", &resource.Sweeper{
 		Name: "aws_route53recoverycontrolconfig_routing_control",
 		F:    sweepRoutingControls,
 	})
 
-	resource.AddTestSweepers("aws_route53recoverycontrolconfig_safety_rule", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_route53recoverycontrolconfig_safety_rule", &resource.Sweeper{
 		Name: "aws_route53recoverycontrolconfig_safety_rule",
 		F:    sweepSafetyRules,
 	})
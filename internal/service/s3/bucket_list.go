@@ -0,0 +1,225 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// Maximum number of concurrent GetBucketTagging calls made when filtering listed buckets by tags.
+	bucketListTagsConcurrency = 10
+)
+
+// @SDKListResource("aws_s3_bucket")
+func bucketResourceAsListResource() inttypes.ListResourceForSDK {
+	l := bucketListResource{}
+	l.SetResourceSchema(resourceBucket())
+
+	return &l
+}
+
+var _ list.ListResourceWithRawV5Schemas = &bucketListResource{}
+
+type bucketListResource struct {
+	framework.ResourceWithConfigure
+	framework.ListResourceWithSDKv2Resource
+	framework.ListResourceWithSDKv2Tags
+}
+
+type bucketListResourceModel struct {
+	framework.WithRegionModel
+	Prefix types.String `tfsdk:"prefix"`
+	Tags   tftags.Map   `tfsdk:"tags"`
+}
+
+func (l *bucketListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			names.AttrPrefix: listschema.StringAttribute{
+				Description: "Bucket name prefix. Only buckets whose names begin with the prefix are listed.",
+				Optional:    true,
+			},
+			names.AttrTags: listschema.MapAttribute{
+				CustomType:  tftags.MapType,
+				ElementType: types.StringType,
+				Description: "Map of tags. Only buckets that have all of the specified tags are listed.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (l *bucketListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var query bucketListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	awsClient := l.Meta()
+	conn := awsClient.S3Client(ctx)
+
+	// ListBuckets filters by Region server-side.
+	input := s3.ListBucketsInput{
+		BucketRegion: aws.String(awsClient.Region(ctx)),
+	}
+	if v := query.Prefix.ValueString(); v != "" {
+		input.Prefix = aws.String(v)
+	}
+	filterTags := tftags.New(ctx, query.Tags)
+
+	tflog.Info(ctx, "Listing S3 buckets")
+	stream.Results = func(yield func(list.ListResult) bool) {
+		pages := s3.NewListBucketsPaginator(conn, &input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			var bucketTags []bucketListTagsResult
+			if len(filterTags) > 0 {
+				bucketTags, err = bucketsListTags(ctx, conn, page.Buckets)
+				if err != nil {
+					result := fwdiag.NewListResultErrorDiagnostic(err)
+					yield(result)
+					return
+				}
+			}
+
+			for i, v := range page.Buckets {
+				bucket := aws.ToString(v.Name)
+
+				if bucketTags != nil {
+					if !bucketTags[i].found || !bucketTags[i].tags.ContainsAll(filterTags) {
+						continue
+					}
+				}
+
+				ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrBucket), bucket)
+
+				result := request.NewListResult(ctx)
+
+				rd := l.ResourceData()
+				rd.SetId(bucket)
+				rd.Set(names.AttrBucket, bucket)
+
+				if request.IncludeResource {
+					diags := resourceBucketRead(ctx, rd, awsClient)
+					if diags.HasError() || rd.Id() == "" {
+						// Resource can't be read or is logically deleted.
+						// Log and continue.
+						tflog.Error(ctx, "Reading S3 bucket", map[string]any{
+							names.AttrBucket: bucket,
+							"diags":          sdkdiag.DiagnosticsString(diags),
+						})
+						continue
+					}
+
+					// Avoid listing tags again when they were retrieved for filtering.
+					if bucketTags != nil {
+						setTagsOut(ctx, svcTags(bucketTags[i].tags))
+					}
+
+					if err := l.SetTags(ctx, awsClient, rd); err != nil {
+						result = fwdiag.NewListResultErrorDiagnostic(err)
+						yield(result)
+						return
+					}
+				}
+
+				result.DisplayName = bucket
+
+				l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+				if result.Diagnostics.HasError() {
+					yield(result)
+					return
+				}
+
+				if !yield(result) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// bucketListTagsResult holds the tags of a listed bucket.
+// found is false if the bucket was deleted after being listed.
+type bucketListTagsResult struct {
+	tags  tftags.KeyValueTags
+	found bool
+}
+
+// bucketsListTags lists the tags of the specified buckets with at most bucketListTagsConcurrency calls in flight.
+// Results are returned in the same order as the buckets.
+func bucketsListTags(ctx context.Context, conn *s3.Client, buckets []awstypes.Bucket) ([]bucketListTagsResult, error) {
+	var (
+		wg      sync.WaitGroup
+		sem     = make(chan struct{}, bucketListTagsConcurrency)
+		results = make([]bucketListTagsResult, len(buckets))
+		errList = make([]error, len(buckets))
+	)
+
+	for i, v := range buckets {
+		bucket := aws.ToString(v.Name)
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			tags, err := bucketListTags(ctx, conn, bucket)
+
+			if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
+				return
+			}
+
+			if err != nil {
+				errList[i] = fmt.Errorf("listing tags for S3 Bucket (%s): %w", bucket, err)
+				return
+			}
+
+			results[i] = bucketListTagsResult{
+				tags:  tags,
+				found: true,
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if err := errors.Join(errList...); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
@@ -7,6 +7,8 @@ package s3
 
 import (
 	"context"
+	"iter"
+	"slices"
 	"unique"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -361,6 +363,22 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 	}
 }
 
+func (p *servicePackage) SDKListResources(ctx context.Context) iter.Seq[*inttypes.ServicePackageSDKListResource] {
+	return slices.Values([]*inttypes.ServicePackageSDKListResource{
+		{
+			Factory:  bucketResourceAsListResource,
+			TypeName: "aws_s3_bucket",
+			Name:     "Bucket",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+			Tags: unique.Make(inttypes.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrBucket,
+				ResourceType:        "Bucket",
+			}),
+			Identity: inttypes.RegionalSingleParameterIdentity(names.AttrBucket),
+		},
+	})
+}
+
 func (p *servicePackage) ServicePackageName() string {
 	return names.S3
 }
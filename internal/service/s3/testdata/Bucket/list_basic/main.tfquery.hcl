@@ -0,0 +1,10 @@
+# Copyright IBM Corp. 2014, 2025
+# SPDX-License-Identifier: MPL-2.0
+
+list "aws_s3_bucket" "test" {
+  provider = aws
+
+  config {
+    prefix = var.rName
+  }
+}
@@ -0,0 +1,19 @@
+# Copyright IBM Corp. 2014, 2025
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_s3_bucket" "test" {
+  count = 3
+
+  bucket = "${var.rName}-${count.index}"
+
+  tags = {
+    Name     = var.rName
+    Selected = count.index < 2 ? "yes" : "no"
+  }
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
@@ -11,17 +11,17 @@ import (
 	// "github.com/aws/aws-sdk-go-v2/aws"
 	// "github.com/aws/aws-sdk-go-v2/service/schemas"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
-	// "github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	// "github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_schemas_discoverer", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_schemas_discoverer", &resource.Sweeper{
 		Name: "aws_schemas_discoverer",
 		F:    sweepDiscoverers,
 	})
 
-	resource.AddTestSweepers("aws_schemas_registry", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_schemas_registry", &resource.Sweeper{
 		Name: "aws_schemas_registry",
 		F:    sweepRegistries,
 		Dependencies: []string{
@@ -29,7 +29,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_schemas_schema", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_schemas_schema", &resource.Sweeper{
 		Name: "aws_schemas_registry",
 		F:    sweepSchemas,
 	})
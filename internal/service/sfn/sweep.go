@@ -15,12 +15,12 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_sfn_activity", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_sfn_activity", &resource.Sweeper{
 		Name: "aws_sfn_activity",
 		F:    sweepActivities,
 	})
 
-	resource.AddTestSweepers("aws_sfn_state_machine", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_sfn_state_machine", &resource.Sweeper{
 		Name: "aws_sfn_state_machine",
 		F:    sweepStateMachines,
 	})
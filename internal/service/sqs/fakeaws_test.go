@@ -0,0 +1,189 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package sqs_test
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fakeaws"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func init() {
+	fakeaws.RegisterService(names.SQS, newFakeService)
+}
+
+type fakeQueue struct {
+	attributes map[string]string
+	tags       fakeaws.Tags
+}
+
+// newFakeService returns a fake SQS implementing queue management.
+func newFakeService() fakeaws.Operations {
+	// Queues keyed by URL.
+	queues := make(map[string]*fakeQueue)
+
+	queueURL := func(ctx context.Context, name string) string {
+		return fmt.Sprintf("https://sqs.%s.amazonaws.com/%s/%s", fakeaws.Region(ctx), fakeaws.AccountID, name)
+	}
+
+	findQueue := func(input map[string]any) (*fakeQueue, error) {
+		q, ok := queues[fakeaws.String(input, "QueueUrl")]
+		if !ok {
+			return nil, fakeaws.NewError("AWS.SimpleQueueService.NonExistentQueue", "The specified queue does not exist.")
+		}
+
+		return q, nil
+	}
+
+	setAttributes := func(q *fakeQueue, attributes map[string]any) {
+		for k, v := range attributes {
+			// Empty values clear the attribute.
+			if v := fmt.Sprint(v); v != "" {
+				q.attributes[k] = v
+			} else {
+				delete(q.attributes, k)
+			}
+		}
+
+		if _, ok := q.attributes["KmsMasterKeyId"]; ok {
+			q.attributes["SqsManagedSseEnabled"] = "false"
+			if _, ok := q.attributes["KmsDataKeyReusePeriodSeconds"]; !ok {
+				q.attributes["KmsDataKeyReusePeriodSeconds"] = "300"
+			}
+		} else {
+			delete(q.attributes, "KmsDataKeyReusePeriodSeconds")
+		}
+		q.attributes["LastModifiedTimestamp"] = strconv.FormatInt(fakeaws.Now().Unix(), 10)
+	}
+
+	return fakeaws.Operations{
+		"CreateQueue": func(ctx context.Context, input map[string]any) (map[string]any, error) {
+			name := fakeaws.String(input, "QueueName")
+			url := queueURL(ctx, name)
+
+			if _, ok := queues[url]; ok {
+				return map[string]any{"QueueUrl": url}, nil
+			}
+
+			q := &fakeQueue{
+				attributes: map[string]string{
+					"ApproximateNumberOfMessages":           "0",
+					"ApproximateNumberOfMessagesDelayed":    "0",
+					"ApproximateNumberOfMessagesNotVisible": "0",
+					"CreatedTimestamp":                      strconv.FormatInt(fakeaws.Now().Unix(), 10),
+					"DelaySeconds":                          "0",
+					"MaximumMessageSize":                    "262144",
+					"MessageRetentionPeriod":                "345600",
+					"QueueArn":                              fakeaws.ARN(ctx, "sqs", name),
+					"ReceiveMessageWaitTimeSeconds":         "0",
+					"SqsManagedSseEnabled":                  "true",
+					"VisibilityTimeout":                     "30",
+				},
+				tags: make(fakeaws.Tags),
+			}
+			if strings.HasSuffix(name, ".fifo") {
+				q.attributes["ContentBasedDeduplication"] = "false"
+				q.attributes["DeduplicationScope"] = "queue"
+				q.attributes["FifoQueue"] = "true"
+				q.attributes["FifoThroughputLimit"] = "perQueue"
+			}
+			setAttributes(q, fakeaws.Map(input, "Attributes"))
+			q.tags.AddMap(fakeaws.Map(input, "tags"))
+			queues[url] = q
+
+			return map[string]any{"QueueUrl": url}, nil
+		},
+		"GetQueueUrl": func(ctx context.Context, input map[string]any) (map[string]any, error) {
+			url := queueURL(ctx, fakeaws.String(input, "QueueName"))
+			if _, err := findQueue(map[string]any{"QueueUrl": url}); err != nil {
+				return nil, err
+			}
+
+			return map[string]any{"QueueUrl": url}, nil
+		},
+		"ListQueues": func(ctx context.Context, input map[string]any) (map[string]any, error) {
+			prefix := queueURL(ctx, fakeaws.String(input, "QueueNamePrefix"))
+			urls := make([]any, 0)
+			for _, url := range slices.Sorted(maps.Keys(queues)) {
+				if strings.HasPrefix(url, prefix) {
+					urls = append(urls, url)
+				}
+			}
+
+			return map[string]any{"QueueUrls": urls}, nil
+		},
+		"GetQueueAttributes": func(_ context.Context, input map[string]any) (map[string]any, error) {
+			q, err := findQueue(input)
+			if err != nil {
+				return nil, err
+			}
+
+			attributes := make(map[string]any)
+			for _, v := range fakeaws.List(input, "AttributeNames") {
+				if name := v.(string); name == "All" {
+					for k, v := range q.attributes {
+						attributes[k] = v
+					}
+				} else if v, ok := q.attributes[name]; ok {
+					attributes[name] = v
+				}
+			}
+
+			return map[string]any{"Attributes": attributes}, nil
+		},
+		"SetQueueAttributes": func(_ context.Context, input map[string]any) (map[string]any, error) {
+			q, err := findQueue(input)
+			if err != nil {
+				return nil, err
+			}
+
+			setAttributes(q, fakeaws.Map(input, "Attributes"))
+
+			return nil, nil
+		},
+		"DeleteQueue": func(_ context.Context, input map[string]any) (map[string]any, error) {
+			if _, err := findQueue(input); err != nil {
+				return nil, err
+			}
+
+			delete(queues, fakeaws.String(input, "QueueUrl"))
+
+			return nil, nil
+		},
+		"TagQueue": func(_ context.Context, input map[string]any) (map[string]any, error) {
+			q, err := findQueue(input)
+			if err != nil {
+				return nil, err
+			}
+
+			q.tags.AddMap(fakeaws.Map(input, "Tags"))
+
+			return nil, nil
+		},
+		"UntagQueue": func(_ context.Context, input map[string]any) (map[string]any, error) {
+			q, err := findQueue(input)
+			if err != nil {
+				return nil, err
+			}
+
+			q.tags.Remove(fakeaws.List(input, "TagKeys"))
+
+			return nil, nil
+		},
+		"ListQueueTags": func(_ context.Context, input map[string]any) (map[string]any, error) {
+			q, err := findQueue(input)
+			if err != nil {
+				return nil, err
+			}
+
+			return map[string]any{"Tags": q.tags.Map()}, nil
+		},
+	}
+}
@@ -0,0 +1,220 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm_test
+
+import (
+	"cmp"
+	"context"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fakeaws"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func init() {
+	fakeaws.RegisterService(names.SSM, newFakeService)
+}
+
+type fakeParameter struct {
+	allowedPattern   string
+	arn              string
+	dataType         string
+	description      string
+	keyID            string
+	lastModifiedDate time.Time
+	name             string
+	tags             fakeaws.Tags
+	tier             string
+	typ              string
+	value            string
+	version          int64
+}
+
+func (p *fakeParameter) metadata() map[string]any {
+	return map[string]any{
+		"ARN":              p.arn,
+		"AllowedPattern":   p.allowedPattern,
+		"DataType":         p.dataType,
+		"Description":      p.description,
+		"KeyId":            p.keyID,
+		"LastModifiedDate": p.lastModifiedDate,
+		"Name":             p.name,
+		"Policies":         []any{},
+		"Tier":             p.tier,
+		"Type":             p.typ,
+		"Version":          p.version,
+	}
+}
+
+// newFakeService returns a fake SSM implementing Parameter Store.
+func newFakeService() fakeaws.Operations {
+	// Parameters keyed by Region and name.
+	parameters := make(map[[2]string]*fakeParameter)
+
+	findParameter := func(ctx context.Context, name string) (*fakeParameter, error) {
+		p, ok := parameters[[2]string{fakeaws.Region(ctx), name}]
+		if !ok {
+			return nil, fakeaws.NewError("ParameterNotFound", "Parameter %s not found.", name)
+		}
+
+		return p, nil
+	}
+
+	findTaggedParameter := func(ctx context.Context, input map[string]any) (*fakeParameter, error) {
+		if typ := fakeaws.String(input, "ResourceType"); typ != "Parameter" {
+			return nil, fakeaws.NewError("InvalidResourceType", "resource type %s is not supported", typ)
+		}
+
+		p, err := findParameter(ctx, fakeaws.String(input, "ResourceId"))
+		if err != nil {
+			return nil, fakeaws.NewError("InvalidResourceId", "%s", err)
+		}
+
+		return p, nil
+	}
+
+	return fakeaws.Operations{
+		"PutParameter": func(ctx context.Context, input map[string]any) (map[string]any, error) {
+			name := fakeaws.String(input, "Name")
+			key := [2]string{fakeaws.Region(ctx), name}
+
+			p, ok := parameters[key]
+			if ok {
+				if !fakeaws.Bool(input, "Overwrite") {
+					return nil, fakeaws.NewError("ParameterAlreadyExists", "The parameter already exists. To overwrite this value, set the overwrite option in the request to true.")
+				}
+				if _, ok := input["Tags"]; ok {
+					return nil, fakeaws.NewError("ValidationException", "Invalid request: tags and overwrite can't be used together.")
+				}
+			} else {
+				p = &fakeParameter{
+					arn:      fakeaws.ARN(ctx, "ssm", "parameter/"+strings.TrimPrefix(name, "/")),
+					dataType: "text",
+					name:     name,
+					tags:     make(fakeaws.Tags),
+					tier:     "Standard",
+				}
+				p.tags.AddList(fakeaws.List(input, "Tags"))
+				parameters[key] = p
+			}
+
+			p.allowedPattern = fakeaws.String(input, "AllowedPattern")
+			p.lastModifiedDate = fakeaws.Now()
+			p.typ = fakeaws.String(input, "Type")
+			p.value = fakeaws.String(input, "Value")
+			p.version++
+			if v := fakeaws.String(input, "DataType"); v != "" {
+				p.dataType = v
+			}
+			if v, ok := input["Description"]; ok {
+				p.description = v.(string)
+			}
+			if v := fakeaws.String(input, "Tier"); v != "" {
+				p.tier = v
+			}
+			p.keyID = ""
+			if p.typ == "SecureString" {
+				p.keyID = "alias/aws/ssm"
+				if v := fakeaws.String(input, "KeyId"); v != "" {
+					p.keyID = v
+				}
+			}
+
+			return map[string]any{
+				"Tier":    p.tier,
+				"Version": p.version,
+			}, nil
+		},
+		"GetParameter": func(ctx context.Context, input map[string]any) (map[string]any, error) {
+			p, err := findParameter(ctx, fakeaws.String(input, "Name"))
+			if err != nil {
+				return nil, err
+			}
+
+			return map[string]any{
+				"Parameter": map[string]any{
+					"ARN":              p.arn,
+					"DataType":         p.dataType,
+					"LastModifiedDate": p.lastModifiedDate,
+					"Name":             p.name,
+					"Type":             p.typ,
+					"Value":            p.value,
+					"Version":          p.version,
+				},
+			}, nil
+		},
+		"DescribeParameters": func(ctx context.Context, input map[string]any) (map[string]any, error) {
+			// Only exact-match name filters are supported.
+			var filterNames []string
+			for _, v := range fakeaws.List(input, "ParameterFilters") {
+				filter, _ := v.(map[string]any)
+				if key, option := fakeaws.String(filter, "Key"), fakeaws.String(filter, "Option"); key != "Name" || (option != "" && option != "Equals") {
+					return nil, fakeaws.NewError("InvalidFilterKey", "fakeaws: filter %s %s is not supported", key, option)
+				}
+				for _, v := range fakeaws.List(filter, "Values") {
+					filterNames = append(filterNames, v.(string))
+				}
+			}
+
+			region := fakeaws.Region(ctx)
+			metadata := make([]any, 0)
+			for _, key := range slices.SortedFunc(maps.Keys(parameters), func(x, y [2]string) int { return cmp.Compare(x[1], y[1]) }) {
+				if key[0] != region {
+					continue
+				}
+				if filterNames != nil && !slices.Contains(filterNames, key[1]) {
+					continue
+				}
+				metadata = append(metadata, parameters[key].metadata())
+			}
+
+			return map[string]any{
+				"Parameters": metadata,
+			}, nil
+		},
+		"DeleteParameter": func(ctx context.Context, input map[string]any) (map[string]any, error) {
+			name := fakeaws.String(input, "Name")
+			if _, err := findParameter(ctx, name); err != nil {
+				return nil, err
+			}
+
+			delete(parameters, [2]string{fakeaws.Region(ctx), name})
+
+			return nil, nil
+		},
+		"AddTagsToResource": func(ctx context.Context, input map[string]any) (map[string]any, error) {
+			p, err := findTaggedParameter(ctx, input)
+			if err != nil {
+				return nil, err
+			}
+
+			p.tags.AddList(fakeaws.List(input, "Tags"))
+
+			return nil, nil
+		},
+		"RemoveTagsFromResource": func(ctx context.Context, input map[string]any) (map[string]any, error) {
+			p, err := findTaggedParameter(ctx, input)
+			if err != nil {
+				return nil, err
+			}
+
+			p.tags.Remove(fakeaws.List(input, "TagKeys"))
+
+			return nil, nil
+		},
+		"ListTagsForResource": func(ctx context.Context, input map[string]any) (map[string]any, error) {
+			p, err := findTaggedParameter(ctx, input)
+			if err != nil {
+				return nil, err
+			}
+
+			return map[string]any{
+				"TagList": p.tags.List(),
+			}, nil
+		},
+	}
+}
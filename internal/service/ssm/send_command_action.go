@@ -0,0 +1,399 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// sendCommandPollInterval defines polling cadence for the send command action.
+	sendCommandPollInterval = 5 * time.Second
+
+	// sendCommandOutputMaxLength is the maximum length of stdout and stderr included in diagnostics.
+	sendCommandOutputMaxLength = 1000
+)
+
+// @Action(aws_ssm_send_command, name="Send Command")
+func newSendCommandAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &sendCommandAction{}, nil
+}
+
+var (
+	_ action.Action = (*sendCommandAction)(nil)
+)
+
+type sendCommandAction struct {
+	framework.ActionWithModel[sendCommandModel]
+}
+
+type sendCommandModel struct {
+	framework.WithRegionModel
+	Comment         types.String                                       `tfsdk:"comment"`
+	DocumentName    types.String                                       `tfsdk:"document_name"`
+	DocumentVersion types.String                                       `tfsdk:"document_version"`
+	InstanceIDs     fwtypes.ListOfString                               `tfsdk:"instance_ids"`
+	MaxConcurrency  types.String                                       `tfsdk:"max_concurrency"`
+	MaxErrors       types.String                                       `tfsdk:"max_errors"`
+	Parameters      types.Map                                          `tfsdk:"parameters" autoflex:"-"`
+	Targets         fwtypes.ListNestedObjectValueOf[sendCommandTarget] `tfsdk:"targets"`
+	Timeout         types.Int64                                        `tfsdk:"timeout"`
+}
+
+type sendCommandTarget struct {
+	Key    types.String         `tfsdk:"key"`
+	Values fwtypes.ListOfString `tfsdk:"values"`
+}
+
+// sendCommandResult is the latest state of a command and its per-instance invocations.
+type sendCommandResult struct {
+	command     *awstypes.Command
+	invocations []awstypes.CommandInvocation
+}
+
+func (a *sendCommandAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Runs an SSM document on managed nodes and waits for every invocation to finish.",
+		Attributes: map[string]schema.Attribute{
+			names.AttrComment: schema.StringAttribute{
+				Description: "User-specified information about the command",
+				Optional:    true,
+			},
+			"document_name": schema.StringAttribute{
+				Description: "Name or ARN of the SSM document to run",
+				Required:    true,
+			},
+			"document_version": schema.StringAttribute{
+				Description: "Version of the SSM document to run",
+				Optional:    true,
+			},
+			"instance_ids": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Description: "IDs of the managed nodes on which to run the command",
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 50),
+					listvalidator.ExactlyOneOf(path.MatchRoot("targets")),
+				},
+			},
+			"max_concurrency": schema.StringAttribute{
+				Description: "Maximum number or percentage of managed nodes on which the command runs at the same time",
+				Optional:    true,
+			},
+			"max_errors": schema.StringAttribute{
+				Description: "Maximum number or percentage of errors allowed before the command stops being sent to other managed nodes",
+				Optional:    true,
+			},
+			names.AttrParameters: schema.MapAttribute{
+				ElementType: types.ListType{ElemType: types.StringType},
+				Description: "Parameters to pass to the SSM document",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for all invocations to finish (default: 1800)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(30),
+					int64validator.AtMost(172800),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"targets": schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[sendCommandTarget](ctx),
+				Description: "Targets that select managed nodes by tag or resource group",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(5),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrKey: schema.StringAttribute{
+							Description: "Target key, such as tag:Environment or resource-groups:Name",
+							Required:    true,
+						},
+						names.AttrValues: schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Description: "Target values",
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *sendCommandAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config sendCommandModel
+
+	// Parse configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get AWS client
+	conn := a.Meta().SSMClient(ctx)
+
+	documentName := config.DocumentName.ValueString()
+
+	// Set default timeout if not provided
+	timeout := 1800 * time.Second
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	var input ssm.SendCommandInput
+	resp.Diagnostics.Append(fwflex.Expand(ctx, config, &input)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Parameters.IsNull() {
+		resp.Diagnostics.Append(config.Parameters.ElementsAs(ctx, &input.Parameters, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	tflog.Info(ctx, "Starting SSM send command action", map[string]any{
+		"document_name":   documentName,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Sending command using SSM document %s...", documentName),
+	})
+
+	output, err := conn.SendCommand(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Send Command",
+			fmt.Sprintf("Could not send command using SSM document %s: %s", documentName, err),
+		)
+		return
+	}
+
+	commandID := aws.ToString(output.Command.CommandId)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Command %s sent, waiting for all invocations to finish...", commandID),
+	})
+
+	// Per-instance invocation statuses seen so far, used to report transitions as they happen.
+	invocationStatuses := make(map[string]awstypes.CommandInvocationStatus)
+
+	fr, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*sendCommandResult], error) {
+		command, err := findCommandByID(ctx, conn, commandID)
+		if retry.NotFound(err) {
+			// The command may not be visible immediately after it is sent.
+			return actionwait.FetchResult[*sendCommandResult]{Status: actionwait.Status(awstypes.CommandStatusPending)}, nil
+		}
+		if err != nil {
+			return actionwait.FetchResult[*sendCommandResult]{}, fmt.Errorf("describing command: %w", err)
+		}
+
+		invocations, err := findCommandInvocationsByCommandID(ctx, conn, commandID)
+		if err != nil {
+			return actionwait.FetchResult[*sendCommandResult]{}, fmt.Errorf("listing command invocations: %w", err)
+		}
+
+		for _, v := range invocations {
+			instanceID := aws.ToString(v.InstanceId)
+			if previous, ok := invocationStatuses[instanceID]; !ok || previous != v.Status {
+				invocationStatuses[instanceID] = v.Status
+				resp.SendProgress(action.InvokeProgressEvent{
+					Message: fmt.Sprintf("Command %s on instance %s is %s", commandID, instanceID, v.Status),
+				})
+			}
+		}
+
+		return actionwait.FetchResult[*sendCommandResult]{
+			Status: actionwait.Status(command.Status),
+			Value:  &sendCommandResult{command: command, invocations: invocations},
+		}, nil
+	}, actionwait.Options[*sendCommandResult]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(sendCommandPollInterval),
+		ProgressInterval: 30 * time.Second,
+		SuccessStates:    []actionwait.Status{actionwait.Status(awstypes.CommandStatusSuccess)},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.CommandStatusPending),
+			actionwait.Status(awstypes.CommandStatusInProgress),
+			actionwait.Status(awstypes.CommandStatusCancelling),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.CommandStatusCancelled),
+			actionwait.Status(awstypes.CommandStatusFailed),
+			actionwait.Status(awstypes.CommandStatusTimedOut),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			if result, ok := fr.Value.(*sendCommandResult); ok && result != nil {
+				resp.SendProgress(action.InvokeProgressEvent{
+					Message: fmt.Sprintf("Command %s is %s: %d of %d invocations completed, %d errors", commandID, fr.Status, result.command.CompletedCount, result.command.TargetCount, result.command.ErrorCount),
+				})
+			}
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Command",
+				fmt.Sprintf("Command %s did not finish within %s: %s", commandID, timeout, err),
+			)
+		} else if errors.As(err, &failureErr) {
+			var details []string
+			if fr.Value != nil {
+				details = failedCommandInvocationDetails(ctx, conn, commandID, fr.Value.invocations)
+			}
+			resp.Diagnostics.AddError(
+				"Command Failed",
+				fmt.Sprintf("Command %s finished with status %s.\n\n%s", commandID, failureErr.Status, strings.Join(details, "\n\n")),
+			)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected Command State",
+				fmt.Sprintf("Command %s entered unexpected state: %s", commandID, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Command",
+				fmt.Sprintf("Error while waiting for command %s: %s", commandID, err),
+			)
+		}
+		return
+	}
+
+	// Final success message
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Command %s completed successfully on %d instances", commandID, len(fr.Value.invocations)),
+	})
+
+	tflog.Info(ctx, "SSM send command action completed successfully", map[string]any{
+		"command_id":    commandID,
+		"document_name": documentName,
+	})
+}
+
+func findCommandByID(ctx context.Context, conn *ssm.Client, id string) (*awstypes.Command, error) {
+	input := ssm.ListCommandsInput{
+		CommandId: aws.String(id),
+	}
+
+	output, err := conn.ListCommands(ctx, &input)
+
+	if errs.IsA[*awstypes.InvalidCommandId](err) {
+		return nil, &sdkretry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output.Commands)
+}
+
+func findCommandInvocationsByCommandID(ctx context.Context, conn *ssm.Client, id string) ([]awstypes.CommandInvocation, error) {
+	input := ssm.ListCommandInvocationsInput{
+		CommandId: aws.String(id),
+		Details:   true,
+	}
+	var output []awstypes.CommandInvocation
+
+	pages := ssm.NewListCommandInvocationsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.CommandInvocations...)
+	}
+
+	return output, nil
+}
+
+// failedCommandInvocationDetails describes each unsuccessful invocation, including the
+// truncated standard output and standard error of each of its plugins.
+func failedCommandInvocationDetails(ctx context.Context, conn *ssm.Client, commandID string, invocations []awstypes.CommandInvocation) []string {
+	var details []string
+
+	for _, invocation := range invocations {
+		if invocation.Status == awstypes.CommandInvocationStatusSuccess {
+			continue
+		}
+
+		instanceID := aws.ToString(invocation.InstanceId)
+		detail := fmt.Sprintf("Instance %s: %s", instanceID, aws.ToString(invocation.StatusDetails))
+
+		for _, plugin := range invocation.CommandPlugins {
+			input := ssm.GetCommandInvocationInput{
+				CommandId:  aws.String(commandID),
+				InstanceId: aws.String(instanceID),
+				PluginName: plugin.Name,
+			}
+
+			output, err := conn.GetCommandInvocation(ctx, &input)
+			if err != nil {
+				detail += fmt.Sprintf("\n  Plugin %s: reading output: %s", aws.ToString(plugin.Name), err)
+				continue
+			}
+
+			detail += fmt.Sprintf("\n  Plugin %s (%s, response code %d)", aws.ToString(plugin.Name), output.Status, output.ResponseCode)
+			if v := aws.ToString(output.StandardOutputContent); v != "" {
+				detail += "\n  stdout: " + truncateCommandOutput(v)
+			}
+			if v := aws.ToString(output.StandardErrorContent); v != "" {
+				detail += "\n  stderr: " + truncateCommandOutput(v)
+			}
+		}
+
+		details = append(details, detail)
+	}
+
+	return details
+}
+
+// truncateCommandOutput keeps the end of the output, where errors are usually reported.
+func truncateCommandOutput(s string) string {
+	s = strings.TrimSpace(s)
+	if len(s) <= sendCommandOutputMaxLength {
+		return s
+	}
+
+	return "..." + s[len(s)-sendCommandOutputMaxLength:]
+}
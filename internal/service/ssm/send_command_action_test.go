@@ -0,0 +1,185 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSMSendCommandAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstancesDataSourceConfig_filterInstance(rName),
+			},
+			{
+				PreConfig: testAccSendCommandActionRegistrationSleep,
+				Config:    testAccSendCommandActionConfig_instanceIDs(rName, "echo hello"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSendCommandSucceeded(ctx, resourceName, 1),
+				),
+			},
+			{
+				Config: testAccSendCommandActionConfig_targets(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSendCommandSucceeded(ctx, resourceName, 2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMSendCommandAction_failure(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstancesDataSourceConfig_filterInstance(rName),
+			},
+			{
+				PreConfig:   testAccSendCommandActionRegistrationSleep,
+				Config:      testAccSendCommandActionConfig_instanceIDs(rName, "echo tf-acc-test-stderr >&2; exit 3"),
+				ExpectError: regexache.MustCompile(`(?s)Command Failed.*tf-acc-test-stderr`),
+			},
+		},
+	})
+}
+
+func testAccSendCommandActionRegistrationSleep() {
+	log.Print("[DEBUG] Test: Sleep to allow SSM Agent to register EC2 instance as a managed node.")
+	time.Sleep(1 * time.Minute)
+}
+
+func testAccCheckSendCommandSucceeded(ctx context.Context, n string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMClient(ctx)
+
+		input := ssm.ListCommandsInput{
+			InstanceId: aws.String(rs.Primary.ID),
+		}
+
+		output, err := conn.ListCommands(ctx, &input)
+		if err != nil {
+			return fmt.Errorf("error listing SSM commands for instance %s: %w", rs.Primary.ID, err)
+		}
+
+		var got int
+		for _, v := range output.Commands {
+			if v.Status == awstypes.CommandStatusSuccess {
+				got++
+			}
+		}
+
+		if got != want {
+			return fmt.Errorf("expected %d successful SSM commands for instance %s, got %d", want, rs.Primary.ID, got)
+		}
+
+		return nil
+	}
+}
+
+func testAccSendCommandActionConfig_instanceIDs(rName, command string) string {
+	return acctest.ConfigCompose(
+		testAccInstancesDataSourceConfig_filterInstance(rName),
+		fmt.Sprintf(`
+action "aws_ssm_send_command" "test" {
+  config {
+    document_name = "AWS-RunShellScript"
+    instance_ids  = [aws_instance.test.id]
+    comment       = %[1]q
+    timeout       = 600
+
+    parameters = {
+      commands = [%[2]q]
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "instance_ids"
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_ssm_send_command.test]
+    }
+  }
+
+  depends_on = [aws_instance.test]
+}
+`, rName, command))
+}
+
+func testAccSendCommandActionConfig_targets(rName string) string {
+	return acctest.ConfigCompose(
+		testAccInstancesDataSourceConfig_filterInstance(rName),
+		fmt.Sprintf(`
+action "aws_ssm_send_command" "test" {
+  config {
+    document_name   = "AWS-RunShellScript"
+    max_concurrency = "50%%"
+    max_errors      = "0"
+
+    targets {
+      key    = "tag:Name"
+      values = [%[1]q]
+    }
+
+    parameters = {
+      commands = ["echo hello"]
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "targets"
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_ssm_send_command.test]
+    }
+  }
+
+  depends_on = [aws_instance.test]
+}
+`, rName))
+}
@@ -15,13 +15,13 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_timestreamwrite_database", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_timestreamwrite_database", &resource.Sweeper{
 		Name:         "aws_timestreamwrite_database",
 		F:            sweepDatabases,
 		Dependencies: []string{"aws_timestreamwrite_table"},
 	})
 
-	resource.AddTestSweepers("aws_timestreamwrite_table", &resource.Sweeper{
+	sweep.AddOrchestratedTestSweepers("aws_timestreamwrite_table", &resource.Sweeper{
 		Name: "aws_timestreamwrite_table",
 		F:    sweepTables,
 	})
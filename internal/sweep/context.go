@@ -10,6 +10,13 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/log"
 )
 
+type contextKey int
+
+const (
+	contextKeyRegion contextKey = iota
+	contextKeyResourceType
+)
+
 func Context(region string) context.Context {
 	ctx := context.Background()
 
@@ -17,5 +24,26 @@ func Context(region string) context.Context {
 
 	ctx = log.Logger(ctx, "sweeper", region)
 
+	ctx = context.WithValue(ctx, contextKeyRegion, region)
+
 	return ctx
 }
+
+// WithResourceType returns a context for sweeping resources of the specified type.
+func WithResourceType(ctx context.Context, resourceType string) context.Context {
+	ctx = log.WithResourceType(ctx, resourceType)
+
+	ctx = context.WithValue(ctx, contextKeyResourceType, resourceType)
+
+	return ctx
+}
+
+func regionFromContext(ctx context.Context) string {
+	v, _ := ctx.Value(contextKeyRegion).(string)
+	return v
+}
+
+func resourceTypeFromContext(ctx context.Context) string {
+	v, _ := ctx.Value(contextKeyResourceType).(string)
+	return v
+}
@@ -0,0 +1,252 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/filter"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Resource describes a resource that is a candidate for sweeping.
+type Resource = filter.Resource
+
+// Filter restricts which resources are swept.
+type Filter = filter.Filter
+
+// options configures SweepOrchestrator. They are set in TestMain.
+var options struct {
+	dryRun bool
+	filter Filter
+	report *report
+}
+
+type describedSweepResource struct {
+	Sweepable
+	resource Resource
+}
+
+// NewDescribedSweepResource returns a Sweepable with an explicit description used for filtering.
+// Use this when the description cannot be derived from the resource's attributes, e.g. to supply tags or creation time from a List API response.
+func NewDescribedSweepResource(sweepable Sweepable, resource Resource) Sweepable {
+	return &describedSweepResource{
+		Sweepable: sweepable,
+		resource:  resource,
+	}
+}
+
+func (dsr *describedSweepResource) Describe(context.Context) Resource {
+	return dsr.resource
+}
+
+func describe(ctx context.Context, sweepable Sweepable) (Resource, bool) {
+	if v, ok := sweepable.(filter.Describer); ok {
+		return v.Describe(ctx), true
+	}
+
+	return Resource{}, false
+}
+
+type sweepCandidate struct {
+	sweepable Sweepable
+	resource  Resource
+}
+
+// filterSweepables returns the sweepables that match the configured filter.
+func filterSweepables(ctx context.Context, sweepables []Sweepable) []sweepCandidate {
+	now := time.Now()
+	candidates := make([]sweepCandidate, 0, len(sweepables))
+	var unknown []string
+	unevaluable := 0
+
+	for _, sweepable := range sweepables {
+		resource, ok := describe(ctx, sweepable)
+
+		if !options.filter.IsEmpty() {
+			reason := "resource cannot be described"
+			if ok {
+				ok, reason = options.filter.Match(resource, now)
+			}
+
+			if v := options.filter.Unknown(resource); len(v) > 0 {
+				unknown = v
+				unevaluable++
+			}
+
+			if !ok {
+				tflog.Debug(ctx, "Skipping resource", map[string]any{
+					names.AttrID: resource.ID,
+					"reason":     reason,
+				})
+				options.report.add(ctx, resource, reportActionSkipped, reason, nil)
+
+				continue
+			}
+		}
+
+		candidates = append(candidates, sweepCandidate{
+			sweepable: sweepable,
+			resource:  resource,
+		})
+	}
+
+	// A sweeper that doesn't describe the filtered properties of any of its resources can never match the filter.
+	if unevaluable > 0 && unevaluable == len(sweepables) {
+		reason := fmt.Sprintf("sweeper does not report resource %s, so no resource can match the filter", strings.Join(unknown, " or "))
+		tflog.Warn(ctx, "Sweeper cannot evaluate filter", map[string]any{
+			"reason": reason,
+		})
+		options.report.add(ctx, Resource{}, reportActionSkipped, reason, nil)
+	}
+
+	return candidates
+}
+
+func deleteCandidate(ctx context.Context, candidate sweepCandidate, optFns ...tfresource.OptionsFunc) error {
+	if options.dryRun {
+		tflog.Info(ctx, "Would sweep resource", map[string]any{
+			names.AttrID: candidate.resource.ID,
+		})
+		options.report.add(ctx, candidate.resource, reportActionWouldDelete, "", nil)
+
+		return nil
+	}
+
+	err := candidate.sweepable.Delete(ctx, optFns...)
+
+	if err != nil {
+		options.report.add(ctx, candidate.resource, reportActionDeleteFailed, "", err)
+	} else {
+		options.report.add(ctx, candidate.resource, reportActionDeleted, "", nil)
+	}
+
+	return err
+}
+
+type reportAction string
+
+const (
+	reportActionDeleteFailed reportAction = "delete_failed"
+	reportActionDeleted      reportAction = "deleted"
+	reportActionSkipped      reportAction = "skipped"
+	reportActionWouldDelete  reportAction = "would_delete"
+)
+
+type reportEntry struct {
+	Region       string `json:"region"`
+	ResourceType string `json:"resource_type,omitempty"`
+	Resource
+	Action reportAction `json:"action"`
+	Reason string       `json:"reason,omitempty"`
+	Error  string       `json:"error,omitempty"`
+}
+
+// report collects the outcome for each resource considered for sweeping.
+type report struct {
+	mu      sync.Mutex
+	entries []reportEntry
+}
+
+func newReport() *report {
+	return &report{
+		entries: make([]reportEntry, 0),
+	}
+}
+
+func (r *report) add(ctx context.Context, resource Resource, action reportAction, reason string, err error) {
+	if r == nil {
+		return
+	}
+
+	entry := reportEntry{
+		Region:       regionFromContext(ctx),
+		ResourceType: resourceTypeFromContext(ctx),
+		Resource:     resource,
+		Action:       action,
+		Reason:       reason,
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.entries = append(r.entries, entry)
+}
+
+// resourceTypeEntries returns the entries for the resource type, sorted by Region and ID.
+func (r *report) resourceTypeEntries(resourceType string) []reportEntry {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var entries []reportEntry
+	for _, entry := range r.entries {
+		if entry.ResourceType == resourceType {
+			entries = append(entries, entry)
+		}
+	}
+	slices.SortStableFunc(entries, func(a, b reportEntry) int {
+		return cmp.Or(
+			cmp.Compare(a.Region, b.Region),
+			cmp.Compare(a.ID, b.ID),
+		)
+	})
+
+	return entries
+}
+
+// planLine describes the entry on a single line, e.g. "us-west-2: i-123 (web) would_delete".
+func (e reportEntry) planLine() string {
+	var sb strings.Builder
+
+	sb.WriteString(e.Region + ": ")
+	if e.ID != "" {
+		sb.WriteString(e.ID)
+		if e.Name != "" && e.Name != e.ID {
+			sb.WriteString(" (" + e.Name + ")")
+		}
+		sb.WriteString(" ")
+	}
+	sb.WriteString(string(e.Action))
+	if e.Reason != "" {
+		sb.WriteString(" (" + e.Reason + ")")
+	}
+	if e.Error != "" {
+		sb.WriteString(": " + e.Error)
+	}
+
+	return sb.String()
+}
+
+// write writes the report as JSON to w.
+func (r *report) write(w io.Writer) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entries := slices.Clone(r.entries)
+	slices.SortStableFunc(entries, func(a, b reportEntry) int {
+		return cmp.Or(
+			cmp.Compare(a.Region, b.Region),
+			cmp.Compare(a.ResourceType, b.ResourceType),
+			cmp.Compare(a.ID, b.ID),
+		)
+	})
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(entries)
+}
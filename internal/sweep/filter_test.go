@@ -0,0 +1,265 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+type testSweepable struct {
+	deleted *atomic.Int32
+}
+
+func (ts testSweepable) Delete(context.Context, ...tfresource.OptionsFunc) error {
+	ts.deleted.Add(1)
+	return nil
+}
+
+func TestSweepOrchestrator_filter(t *testing.T) { //nolint:paralleltest // Modifies package options
+	created := time.Now().Add(-48 * time.Hour)
+
+	testCases := map[string]struct {
+		dryRun          bool
+		filter          Filter
+		expectedDeleted int32
+		expectedActions map[string]reportAction
+	}{
+		"no filter": {
+			expectedDeleted: 3,
+			expectedActions: map[string]reportAction{
+				"":          reportActionDeleted,
+				"tf-acc-1":  reportActionDeleted,
+				"shared-01": reportActionDeleted,
+			},
+		},
+		"name prefix": {
+			filter:          Filter{NamePrefix: "tf-acc-"},
+			expectedDeleted: 1,
+			expectedActions: map[string]reportAction{
+				"":          reportActionSkipped,
+				"tf-acc-1":  reportActionDeleted,
+				"shared-01": reportActionSkipped,
+			},
+		},
+		"tag and age": {
+			filter:          Filter{Tags: map[string]string{"Owner": "ci"}, MinAge: 24 * time.Hour},
+			expectedDeleted: 1,
+			expectedActions: map[string]reportAction{
+				"":          reportActionSkipped,
+				"tf-acc-1":  reportActionDeleted,
+				"shared-01": reportActionSkipped,
+			},
+		},
+		"dry run": {
+			dryRun:          true,
+			filter:          Filter{NamePrefix: "tf-acc-"},
+			expectedDeleted: 0,
+			expectedActions: map[string]reportAction{
+				"":          reportActionSkipped,
+				"tf-acc-1":  reportActionWouldDelete,
+				"shared-01": reportActionSkipped,
+			},
+		},
+	}
+
+	for name, testCase := range testCases { //nolint:paralleltest // Modifies package options
+		t.Run(name, func(t *testing.T) {
+			t.Cleanup(func() {
+				options.dryRun = false
+				options.filter = Filter{}
+				options.report = nil
+			})
+			options.dryRun = testCase.dryRun
+			options.filter = testCase.filter
+			options.report = newReport()
+
+			var deleted atomic.Int32
+			sweepables := []Sweepable{
+				// Not describable.
+				testSweepable{deleted: &deleted},
+				NewDescribedSweepResource(testSweepable{deleted: &deleted}, Resource{
+					ID:        "tf-acc-1",
+					Tags:      map[string]string{"Owner": "ci"},
+					CreatedAt: &created,
+				}),
+				NewDescribedSweepResource(testSweepable{deleted: &deleted}, Resource{
+					ID:   "shared-01",
+					Tags: map[string]string{"Owner": "other-team"},
+				}),
+			}
+
+			ctx := WithResourceType(Context("us-west-2"), "aws_test_thing")
+			if err := SweepOrchestrator(ctx, sweepables); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := deleted.Load(), testCase.expectedDeleted; got != want {
+				t.Errorf("deleted %d resources, want %d", got, want)
+			}
+
+			actions := make(map[string]reportAction)
+			for _, entry := range options.report.entries {
+				if entry.Region != "us-west-2" || entry.ResourceType != "aws_test_thing" {
+					t.Errorf("unexpected report entry attribution: %s/%s", entry.Region, entry.ResourceType)
+				}
+				actions[entry.ID] = entry.Action
+			}
+			if diff := cmp.Diff(actions, testCase.expectedActions); diff != "" {
+				t.Errorf("unexpected actions diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestSweepOrchestrator_filterUnknown(t *testing.T) { //nolint:paralleltest // Modifies package options
+	t.Cleanup(func() {
+		options.filter = Filter{}
+		options.report = nil
+	})
+	options.filter = Filter{MinAge: time.Hour}
+	options.report = newReport()
+
+	var deleted atomic.Int32
+	sweepables := []Sweepable{
+		NewDescribedSweepResource(testSweepable{deleted: &deleted}, Resource{ID: "id-1"}),
+		NewDescribedSweepResource(testSweepable{deleted: &deleted}, Resource{ID: "id-2"}),
+	}
+
+	ctx := WithResourceType(Context("us-west-2"), "aws_test_thing")
+	if err := SweepOrchestrator(ctx, sweepables); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := deleted.Load(); got != 0 {
+		t.Errorf("deleted %d resources, want 0", got)
+	}
+
+	var reasons []string
+	for _, entry := range options.report.entries {
+		if entry.Action != reportActionSkipped {
+			t.Errorf("unexpected report entry action: %s", entry.Action)
+		}
+		if entry.ID == "" {
+			reasons = append(reasons, entry.Reason)
+		}
+	}
+	if diff := cmp.Diff(reasons, []string{"sweeper does not report resource creation time, so no resource can match the filter"}); diff != "" {
+		t.Errorf("unexpected sweeper reasons diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestSchedulerRun_unorchestrated(t *testing.T) { //nolint:paralleltest // Modifies package options
+	testCases := map[string]struct {
+		dryRun      bool
+		filter      Filter
+		expectedRun []string
+	}{
+		"no filter": {
+			expectedRun: []string{"aws_direct", "aws_orchestrated"},
+		},
+		"dry run": {
+			dryRun:      true,
+			expectedRun: []string{"aws_orchestrated"},
+		},
+		"filter": {
+			filter:      Filter{NamePrefix: "tf-acc-"},
+			expectedRun: []string{"aws_orchestrated"},
+		},
+	}
+
+	for name, testCase := range testCases { //nolint:paralleltest // Modifies package options
+		t.Run(name, func(t *testing.T) {
+			t.Cleanup(func() {
+				options.dryRun = false
+				options.filter = Filter{}
+				options.report = nil
+				delete(orchestrated, "aws_orchestrated")
+			})
+			options.dryRun = testCase.dryRun
+			options.filter = testCase.filter
+			options.report = newReport()
+			orchestrated["aws_orchestrated"] = true
+
+			var mu sync.Mutex
+			var run []string
+			scheduler, err := NewScheduler(testSweepers(map[string][]string{
+				"aws_direct":       nil,
+				"aws_orchestrated": nil,
+			}, func(name, _ string) error {
+				mu.Lock()
+				defer mu.Unlock()
+				run = append(run, name)
+				return nil
+			}))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if _, err := scheduler.Run([]string{"us-west-2"}, 1, false); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			slices.Sort(run)
+			if diff := cmp.Diff(run, testCase.expectedRun); diff != "" {
+				t.Errorf("unexpected sweepers run diff (+wanted, -got): %s", diff)
+			}
+
+			for _, entry := range options.report.entries {
+				if entry.ResourceType != "aws_direct" || entry.Action != reportActionSkipped {
+					t.Errorf("unexpected report entry: %s %s", entry.ResourceType, entry.Action)
+				}
+			}
+		})
+	}
+}
+
+func TestReportWrite(t *testing.T) {
+	t.Parallel()
+
+	created := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	r := newReport()
+	ctx := WithResourceType(Context("us-west-2"), "aws_test_thing")
+	r.add(ctx, Resource{ID: "b", Name: "tf-acc-b", CreatedAt: &created}, reportActionWouldDelete, "", nil)
+	r.add(ctx, Resource{ID: "a", Tags: map[string]string{"Owner": "ci"}}, reportActionSkipped, "creation time unknown", nil)
+
+	var b strings.Builder
+	if err := r.write(&b); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := `[
+  {
+    "region": "us-west-2",
+    "resource_type": "aws_test_thing",
+    "id": "a",
+    "tags": {
+      "Owner": "ci"
+    },
+    "action": "skipped",
+    "reason": "creation time unknown"
+  },
+  {
+    "region": "us-west-2",
+    "resource_type": "aws_test_thing",
+    "id": "b",
+    "name": "tf-acc-b",
+    "created_at": "2025-06-01T12:00:00Z",
+    "action": "would_delete"
+  }
+]
+`
+
+	if diff := cmp.Diff(b.String(), expected); diff != "" {
+		t.Errorf("unexpected report diff (+wanted, -got): %s", diff)
+	}
+}
@@ -5,6 +5,8 @@ package framework
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -16,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/filter"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -111,6 +114,36 @@ func (sr *sweepResource) Delete(ctx context.Context, optFns ...tfresource.Option
 	return err
 }
 
+// Describe returns a description of the resource built from the attributes set by the sweeper.
+// If no ID attribute is set, the ID is built from all the attributes.
+func (sr *sweepResource) Describe(context.Context) filter.Resource {
+	r := filter.FromAttributes(func(k string) (any, bool) {
+		for _, attr := range sr.attributes {
+			if attr.path == k {
+				return attr.value, true
+			}
+		}
+
+		return nil, false
+	})
+
+	if r.ID == "" {
+		parts := make([]string, 0, len(sr.attributes))
+		for _, attr := range sr.attributes {
+			switch v := attr.value.(type) {
+			case *string:
+				parts = append(parts, attr.path+"="+aws.ToString(v))
+
+			default:
+				parts = append(parts, fmt.Sprintf("%s=%v", attr.path, v))
+			}
+		}
+		r.ID = strings.Join(parts, ",")
+	}
+
+	return r
+}
+
 func deleteResource(ctx context.Context, state tfsdk.State, resource fwresource.Resource) error {
 	var response fwresource.DeleteResponse
 	resource.Delete(ctx, fwresource.DeleteRequest{State: state}, &response)
@@ -0,0 +1,189 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package filter
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
+// Resource describes a resource that is a candidate for sweeping.
+type Resource struct {
+	ID        string            `json:"id"`
+	Name      string            `json:"name,omitempty"`
+	Tags      map[string]string `json:"tags,omitempty"`
+	CreatedAt *time.Time        `json:"created_at,omitempty"`
+}
+
+// Describer is implemented by sweepable resources that can describe themselves for filtering.
+type Describer interface {
+	Describe(ctx context.Context) Resource
+}
+
+// Filter restricts which resources are swept.
+// A resource for which a filtered property is unknown never matches.
+type Filter struct {
+	// NamePrefix matches resources whose name (or ID if the name is unknown) starts with the prefix.
+	NamePrefix string
+	// Tags matches resources with all the tags. An empty value matches any value.
+	Tags map[string]string
+	// MinAge matches resources created at least this long ago.
+	MinAge time.Duration
+}
+
+// IsEmpty returns whether the filter matches every resource.
+func (f Filter) IsEmpty() bool {
+	return f.NamePrefix == "" && len(f.Tags) == 0 && f.MinAge == 0
+}
+
+// Match returns whether the resource matches the filter at the specified time.
+// If the resource doesn't match, the reason is returned.
+func (f Filter) Match(r Resource, now time.Time) (bool, string) {
+	if f.NamePrefix != "" {
+		name := r.Name
+		if name == "" {
+			name = r.ID
+		}
+
+		if !strings.HasPrefix(name, f.NamePrefix) {
+			return false, fmt.Sprintf("name %q does not have prefix %q", name, f.NamePrefix)
+		}
+	}
+
+	if len(f.Tags) > 0 && r.Tags == nil {
+		return false, "tags unknown"
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(f.Tags)) {
+		value, ok := r.Tags[key]
+		if !ok {
+			return false, fmt.Sprintf("tag %q not found", key)
+		}
+
+		if want := f.Tags[key]; want != "" && value != want {
+			return false, fmt.Sprintf("tag %q has value %q, want %q", key, value, want)
+		}
+	}
+
+	if f.MinAge != 0 {
+		if r.CreatedAt == nil {
+			return false, "creation time unknown"
+		}
+
+		if age := now.Sub(*r.CreatedAt); age < f.MinAge {
+			return false, fmt.Sprintf("age %s is less than %s", age.Round(time.Second), f.MinAge)
+		}
+	}
+
+	return true, ""
+}
+
+// Unknown returns the properties that the filter matches on but that are unknown for the resource.
+// A resource with unknown properties never matches.
+func (f Filter) Unknown(r Resource) []string {
+	var properties []string
+
+	if len(f.Tags) > 0 && r.Tags == nil {
+		properties = append(properties, "tags")
+	}
+
+	if f.MinAge != 0 && r.CreatedAt == nil {
+		properties = append(properties, "creation time")
+	}
+
+	return properties
+}
+
+// createdAtAttributes are the attribute names commonly used for a resource's creation time.
+var createdAtAttributes = []string{
+	"created_at",
+	"created_date",
+	"created_time",
+	"creation_date",
+	"creation_time",
+	"create_date",
+	"create_time",
+}
+
+// FromAttributes returns a description of a resource from its Terraform attribute values.
+// get returns the value of the specified attribute and whether it is known.
+func FromAttributes(get func(string) (any, bool)) Resource {
+	var r Resource
+
+	if v, ok := get("id"); ok {
+		r.ID = stringValue(v)
+	}
+
+	if v, ok := get("name"); ok {
+		r.Name = stringValue(v)
+	}
+
+	for _, k := range []string{"tags_all", "tags"} {
+		if v, ok := get(k); ok {
+			if tags := mapValue(v); len(tags) > 0 {
+				r.Tags = tags
+				break
+			}
+		}
+	}
+
+	for _, k := range createdAtAttributes {
+		if v, ok := get(k); ok {
+			if t, ok := timeValue(v); ok {
+				r.CreatedAt = &t
+				break
+			}
+		}
+	}
+
+	return r
+}
+
+func stringValue(v any) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case *string:
+		return aws.ToString(v)
+	case interface{ ValueString() string }:
+		return v.ValueString()
+	default:
+		return ""
+	}
+}
+
+func mapValue(v any) map[string]string {
+	switch v := v.(type) {
+	case map[string]string:
+		return v
+	case map[string]*string:
+		return aws.ToStringMap(v)
+	case map[string]any:
+		m := make(map[string]string, len(v))
+		for k, v := range v {
+			m[k] = stringValue(v)
+		}
+		return m
+	default:
+		return nil
+	}
+}
+
+func timeValue(v any) (time.Time, bool) {
+	switch v := v.(type) {
+	case time.Time:
+		return v, !v.IsZero()
+	case *time.Time:
+		return aws.ToTime(v), v != nil && !v.IsZero()
+	default:
+		t, err := time.Parse(time.RFC3339, stringValue(v))
+		return t, err == nil
+	}
+}
@@ -0,0 +1,234 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package filter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFilterMatch(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	created := now.Add(-2 * time.Hour)
+
+	testCases := map[string]struct {
+		filter         Filter
+		resource       Resource
+		expectedMatch  bool
+		expectedReason string
+	}{
+		"empty filter": {
+			resource:      Resource{ID: "id"},
+			expectedMatch: true,
+		},
+		"name prefix match": {
+			filter:        Filter{NamePrefix: "tf-acc-test-"},
+			resource:      Resource{ID: "id", Name: "tf-acc-test-123"},
+			expectedMatch: true,
+		},
+		"name prefix no match": {
+			filter:         Filter{NamePrefix: "tf-acc-test-"},
+			resource:       Resource{ID: "id", Name: "production"},
+			expectedReason: `name "production" does not have prefix "tf-acc-test-"`,
+		},
+		"name prefix ID fallback": {
+			filter:        Filter{NamePrefix: "tf-acc-test-"},
+			resource:      Resource{ID: "tf-acc-test-123"},
+			expectedMatch: true,
+		},
+		"tag key match": {
+			filter:        Filter{Tags: map[string]string{"Owner": ""}},
+			resource:      Resource{ID: "id", Tags: map[string]string{"Owner": "team-a"}},
+			expectedMatch: true,
+		},
+		"tag value match": {
+			filter:        Filter{Tags: map[string]string{"Owner": "team-a"}},
+			resource:      Resource{ID: "id", Tags: map[string]string{"Owner": "team-a", "Other": "x"}},
+			expectedMatch: true,
+		},
+		"tag value no match": {
+			filter:         Filter{Tags: map[string]string{"Owner": "team-a"}},
+			resource:       Resource{ID: "id", Tags: map[string]string{"Owner": "team-b"}},
+			expectedReason: `tag "Owner" has value "team-b", want "team-a"`,
+		},
+		"tag unknown": {
+			filter:         Filter{Tags: map[string]string{"Owner": ""}},
+			resource:       Resource{ID: "id"},
+			expectedReason: "tags unknown",
+		},
+		"tag not found": {
+			filter:         Filter{Tags: map[string]string{"Owner": ""}},
+			resource:       Resource{ID: "id", Tags: map[string]string{"Other": "x"}},
+			expectedReason: `tag "Owner" not found`,
+		},
+		"min age match": {
+			filter:        Filter{MinAge: time.Hour},
+			resource:      Resource{ID: "id", CreatedAt: &created},
+			expectedMatch: true,
+		},
+		"min age too new": {
+			filter:         Filter{MinAge: 3 * time.Hour},
+			resource:       Resource{ID: "id", CreatedAt: &created},
+			expectedReason: "age 2h0m0s is less than 3h0m0s",
+		},
+		"min age unknown": {
+			filter:         Filter{MinAge: time.Hour},
+			resource:       Resource{ID: "id"},
+			expectedReason: "creation time unknown",
+		},
+		"all match": {
+			filter: Filter{
+				NamePrefix: "tf-acc-test-",
+				Tags:       map[string]string{"Owner": "team-a"},
+				MinAge:     time.Hour,
+			},
+			resource: Resource{
+				ID:        "id",
+				Name:      "tf-acc-test-123",
+				Tags:      map[string]string{"Owner": "team-a"},
+				CreatedAt: &created,
+			},
+			expectedMatch: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, reason := testCase.filter.Match(testCase.resource, now)
+
+			if got, want := match, testCase.expectedMatch; got != want {
+				t.Errorf("match = %t, want %t", got, want)
+			}
+			if got, want := reason, testCase.expectedReason; got != want {
+				t.Errorf("reason = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestFilterUnknown(t *testing.T) {
+	t.Parallel()
+
+	created := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		filter   Filter
+		resource Resource
+		expected []string
+	}{
+		"empty filter": {
+			resource: Resource{ID: "id"},
+		},
+		"name prefix": {
+			filter:   Filter{NamePrefix: "tf-acc-test-"},
+			resource: Resource{ID: "id"},
+		},
+		"tags and min age unknown": {
+			filter:   Filter{Tags: map[string]string{"Owner": ""}, MinAge: time.Hour},
+			resource: Resource{ID: "id"},
+			expected: []string{"tags", "creation time"},
+		},
+		"tags and min age known": {
+			filter:   Filter{Tags: map[string]string{"Owner": ""}, MinAge: time.Hour},
+			resource: Resource{ID: "id", Tags: map[string]string{"Other": "x"}, CreatedAt: &created},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if diff := cmp.Diff(testCase.filter.Unknown(testCase.resource), testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFromAttributes(t *testing.T) {
+	t.Parallel()
+
+	created := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		attributes map[string]any
+		expected   Resource
+	}{
+		"empty": {
+			attributes: map[string]any{},
+			expected:   Resource{},
+		},
+		"strings": {
+			attributes: map[string]any{
+				"id":   "id-1",
+				"name": "name-1",
+				"tags": map[string]any{"k1": "v1"},
+			},
+			expected: Resource{
+				ID:   "id-1",
+				Name: "name-1",
+				Tags: map[string]string{"k1": "v1"},
+			},
+		},
+		"pointers": {
+			attributes: map[string]any{
+				"id":           aws.String("id-1"),
+				"name":         aws.String("name-1"),
+				"tags":         map[string]*string{"k1": aws.String("v1")},
+				"created_time": &created,
+			},
+			expected: Resource{
+				ID:        "id-1",
+				Name:      "name-1",
+				Tags:      map[string]string{"k1": "v1"},
+				CreatedAt: &created,
+			},
+		},
+		"tags_all preferred": {
+			attributes: map[string]any{
+				"tags":     map[string]string{"k1": "v1"},
+				"tags_all": map[string]string{"k1": "v1", "k2": "v2"},
+			},
+			expected: Resource{
+				Tags: map[string]string{"k1": "v1", "k2": "v2"},
+			},
+		},
+		"creation date string": {
+			attributes: map[string]any{
+				"creation_date": "2025-06-01T12:00:00Z",
+			},
+			expected: Resource{
+				CreatedAt: &created,
+			},
+		},
+		"invalid creation date": {
+			attributes: map[string]any{
+				"creation_date": "yesterday",
+			},
+			expected: Resource{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := FromAttributes(func(k string) (any, bool) {
+				v, ok := testCase.attributes[k]
+				return v, ok
+			})
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
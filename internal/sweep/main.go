@@ -0,0 +1,145 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const defaultSweepParallelism = 10
+
+// TestMain is a drop-in replacement for resource.TestMain.
+// When the -sweep flag is set, the registered sweepers are run by a Scheduler instead of the Plugin SDK.
+//
+// In addition to the Plugin SDK's sweeper flags the following flags are supported:
+//
+//   - -sweep-parallelism: Maximum number of sweepers to run concurrently in each Region
+//   - -sweep-plan: Print the sweeper execution plan without running any sweepers; with -sweep-dry-run, also list each sweeper's candidate resources
+//   - -sweep-dry-run: List the resources that would be swept without deleting them
+//   - -sweep-name-prefix: Only sweep resources whose name starts with the prefix
+//   - -sweep-tag: Only sweep resources with the tag, specified as key or key=value; may be repeated
+//   - -sweep-min-age: Only sweep resources created at least this long ago
+//   - -sweep-report: Write a JSON report of the resources considered for sweeping to the file, or "-" for standard output
+func TestMain(m interface {
+	Run() int
+}) {
+	parallelism := flag.Int("sweep-parallelism", defaultSweepParallelism, "Maximum number of Sweepers to run concurrently in each Region")
+	plan := flag.Bool("sweep-plan", false, "Print the Sweeper execution plan without running any Sweepers; with -sweep-dry-run, also list each Sweeper's candidate resources")
+	flag.BoolVar(&options.dryRun, "sweep-dry-run", false, "List the resources that would be swept without deleting them")
+	flag.StringVar(&options.filter.NamePrefix, "sweep-name-prefix", "", "Only sweep resources whose name starts with this prefix")
+	flag.Func("sweep-tag", "Only sweep resources with this tag (key or key=value); may be repeated", func(s string) error {
+		key, value, _ := strings.Cut(s, "=")
+		if key == "" {
+			return fmt.Errorf("invalid tag %q: key must not be empty", s)
+		}
+
+		if options.filter.Tags == nil {
+			options.filter.Tags = make(map[string]string)
+		}
+		options.filter.Tags[key] = value
+
+		return nil
+	})
+	flag.DurationVar(&options.filter.MinAge, "sweep-min-age", 0, "Only sweep resources created at least this long ago")
+	reportPath := flag.String("sweep-report", "", "Write a JSON report of the resources considered for sweeping to this file, or - for standard output (default - with -sweep-dry-run)")
+
+	flag.Parse()
+
+	// These flags are registered by the Plugin SDK.
+	regions := flag.Lookup("sweep").Value.String()
+	if regions == "" {
+		resource.TestMain(m)
+		return
+	}
+	filter := flag.Lookup("sweep-run").Value.String()
+	allowFailures := flag.Lookup("sweep-allow-failures").Value.String() == "true"
+
+	if options.dryRun && !*plan && *reportPath == "" {
+		*reportPath = "-"
+	}
+
+	os.Exit(runSweepers(strings.Split(regions, ","), filter, *parallelism, allowFailures, *plan, *reportPath))
+}
+
+func runSweepers(regions []string, filter string, parallelism int, allowFailures, plan bool, reportPath string) int {
+	scheduler, err := NewScheduler(sweepers)
+	if err == nil {
+		scheduler, err = scheduler.Filter(filter)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error building sweeper dependency graph: %s\n", err)
+		return 1
+	}
+
+	if plan {
+		return planSweepers(scheduler, regions, parallelism)
+	}
+
+	if reportPath != "" {
+		options.report = newReport()
+	}
+
+	exitCode := 0
+
+	if _, err := scheduler.Run(regions, parallelism, allowFailures); err != nil {
+		fmt.Fprintf(os.Stderr, "error running sweepers:\n%s\n", err)
+		exitCode = 1
+	}
+
+	if options.report != nil {
+		if err := writeReport(reportPath, options.report); err != nil {
+			fmt.Fprintf(os.Stderr, "error writing sweep report: %s\n", err)
+			exitCode = 1
+		}
+	}
+
+	return exitCode
+}
+
+// planSweepers writes the sweeper execution plan.
+// With -sweep-dry-run, the sweepers are first run in dry-run mode so that the candidate resources of each sweeper are listed.
+func planSweepers(scheduler *Scheduler, regions []string, parallelism int) int {
+	exitCode := 0
+
+	var candidates *report
+	if options.dryRun {
+		options.report = newReport()
+		candidates = options.report
+
+		if _, err := scheduler.Run(regions, parallelism, true); err != nil {
+			fmt.Fprintf(os.Stderr, "error listing sweeper candidates:\n%s\n", err)
+			exitCode = 1
+		}
+	}
+
+	if err := scheduler.Plan(os.Stdout, regions, candidates); err != nil {
+		fmt.Fprintf(os.Stderr, "error writing sweeper plan: %s\n", err)
+		return 1
+	}
+
+	return exitCode
+}
+
+func writeReport(path string, r *report) error {
+	if path == "-" {
+		return r.write(os.Stdout)
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if err := r.write(f); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
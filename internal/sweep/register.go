@@ -0,0 +1,43 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var (
+	// sweepers is the registry of all sweepers, used to build the sweeper dependency graph.
+	sweepers = make(map[string]*resource.Sweeper)
+
+	// orchestrated is the set of sweepers that only delete resources via SweepOrchestrator.
+	orchestrated = make(map[string]bool)
+)
+
+// AddTestSweepers registers a sweeper both with the scheduler and with the Plugin SDK.
+// The sweeper is skipped when sweeping with -sweep-dry-run or any filter, as it may delete resources directly.
+func AddTestSweepers(name string, s *resource.Sweeper) {
+	resource.AddTestSweepers(name, s)
+
+	sweepers[name] = s
+}
+
+// AddOrchestratedTestSweepers registers a sweeper that only deletes resources via SweepOrchestrator.
+// Such sweepers honor -sweep-dry-run and the sweep filters.
+func AddOrchestratedTestSweepers(name string, s *resource.Sweeper) {
+	AddTestSweepers(name, s)
+
+	orchestrated[name] = true
+}
+
+// unorchestratedSkipReason returns the reason a sweeper is skipped, if any.
+// Sweepers that may delete resources without going through SweepOrchestrator would ignore dry-run mode and the filters,
+// so they are not run when either is configured.
+func unorchestratedSkipReason(name string) (string, bool) {
+	if orchestrated[name] || (!options.dryRun && options.filter.IsEmpty()) {
+		return "", false
+	}
+
+	return "sweeper does not support -sweep-dry-run or sweep filters", true
+}
@@ -0,0 +1,296 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/depgraph"
+)
+
+// Scheduler runs sweepers in dependency order, running independent sweepers in parallel.
+// A sweeper's dependencies are run before the sweeper itself.
+type Scheduler struct {
+	graph    *depgraph.Graph
+	order    []string
+	sweepers map[string]*resource.Sweeper
+}
+
+// NewScheduler returns a Scheduler for the specified sweepers.
+// Returns an error if any sweeper has a dependency that is not registered or if a dependency cycle is detected.
+func NewScheduler(sweepers map[string]*resource.Sweeper) (*Scheduler, error) {
+	g := depgraph.New()
+	names := slices.Sorted(maps.Keys(sweepers))
+
+	for _, name := range names {
+		g.AddNode(name)
+	}
+
+	var errs []error
+	for _, name := range names {
+		for _, dependency := range sweepers[name].Dependencies {
+			if err := g.AddDependency(name, dependency); err != nil {
+				errs = append(errs, fmt.Errorf("sweeper (%s) has dependency (%s), but that sweeper was not found", name, dependency))
+			}
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	order, err := g.OverallOrder()
+	if err != nil {
+		return nil, fmt.Errorf("sweepers: %w", err)
+	}
+
+	return &Scheduler{
+		graph:    g,
+		order:    order,
+		sweepers: sweepers,
+	}, nil
+}
+
+// Filter returns a Scheduler for the sweepers whose names contain any of the comma-separated, case-insensitive filter values
+// along with all of their (transitive) dependencies.
+// An empty filter selects all sweepers.
+func (s *Scheduler) Filter(filter string) (*Scheduler, error) {
+	if filter == "" {
+		return s, nil
+	}
+
+	selected := make(map[string]*resource.Sweeper)
+	for _, v := range strings.Split(strings.ToLower(filter), ",") {
+		for _, name := range s.order {
+			if !strings.Contains(strings.ToLower(name), v) {
+				continue
+			}
+
+			dependencies, err := s.graph.DependenciesOf(name)
+			if err != nil {
+				return nil, err
+			}
+
+			selected[name] = s.sweepers[name]
+			for _, dependency := range dependencies {
+				selected[dependency] = s.sweepers[dependency]
+			}
+		}
+	}
+
+	return NewScheduler(selected)
+}
+
+// Order returns the order in which sweepers are run when run sequentially.
+func (s *Scheduler) Order() []string {
+	return slices.Clone(s.order)
+}
+
+// Stages groups the sweepers into stages.
+// Every sweeper in a stage depends only on sweepers in earlier stages, so all sweepers in a stage can be run in parallel.
+func (s *Scheduler) Stages() [][]string {
+	var stages [][]string
+	levels := make(map[string]int)
+
+	// Dependencies appear before their dependents in the overall order.
+	for _, name := range s.order {
+		level := 0
+		dependencies, _ := s.graph.DirectDependenciesOf(name)
+		for _, dependency := range dependencies {
+			level = max(level, levels[dependency]+1)
+		}
+		levels[name] = level
+
+		if level == len(stages) {
+			stages = append(stages, nil)
+		}
+		stages[level] = append(stages[level], name)
+	}
+
+	for _, stage := range stages {
+		slices.Sort(stage)
+	}
+
+	return stages
+}
+
+// Plan writes the sweeper execution plan to w without running any sweepers.
+// If candidates is not nil, the resources it reports for each sweeper, e.g. from a dry run, are listed below the sweeper.
+// Otherwise only the stages and sweeper order are written.
+func (s *Scheduler) Plan(w io.Writer, regions []string, candidates *report) error {
+	stages := s.Stages()
+
+	if _, err := fmt.Fprintf(w, "Sweeper plan for region(s) %s: %d sweeper(s) in %d stage(s)\n", strings.Join(regions, ", "), len(s.order), len(stages)); err != nil {
+		return err
+	}
+
+	for i, stage := range stages {
+		if _, err := fmt.Fprintf(w, "\nStage %d:\n", i+1); err != nil {
+			return err
+		}
+
+		for _, name := range stage {
+			line := "  - " + name
+			if dependencies, _ := s.graph.DirectDependenciesOf(name); len(dependencies) > 0 {
+				line += fmt.Sprintf(" (after %s)", strings.Join(dependencies, ", "))
+			}
+
+			if _, err := fmt.Fprintln(w, line); err != nil {
+				return err
+			}
+
+			if candidates == nil {
+				continue
+			}
+
+			for _, entry := range candidates.resourceTypeEntries(name) {
+				if _, err := fmt.Fprintln(w, "      "+entry.planLine()); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// Run runs the sweepers in each of the specified Regions in turn.
+// Within a Region at most parallelism sweepers are run concurrently and a sweeper is only started once all its dependencies have completed.
+// If allowFailures is false, no further sweepers are started after the first failure.
+// Returns the result of each sweeper that ran, keyed by Region and sweeper name.
+func (s *Scheduler) Run(regions []string, parallelism int, allowFailures bool) (map[string]map[string]error, error) {
+	parallelism = max(parallelism, 1)
+	results := make(map[string]map[string]error)
+	var errs []error
+
+	for _, region := range regions {
+		region = strings.TrimSpace(region)
+		ctx := Context(region)
+
+		tflog.Info(ctx, "Running sweepers", map[string]any{
+			"parallelism":   parallelism,
+			"sweeper_count": len(s.order),
+		})
+		start := time.Now()
+
+		regionResults := s.runRegion(region, parallelism, allowFailures)
+		results[region] = regionResults
+
+		var failed []string
+		for _, name := range s.order {
+			if err, ok := regionResults[name]; ok && err != nil {
+				failed = append(failed, name)
+				errs = append(errs, fmt.Errorf("sweeper (%s) for region (%s) failed: %w", name, region, err))
+			}
+		}
+
+		tflog.Info(ctx, "Completed sweepers", map[string]any{
+			"duration":        time.Since(start).String(),
+			"failed_sweepers": failed,
+			"sweeper_count":   len(regionResults),
+		})
+
+		if len(failed) > 0 && !allowFailures {
+			break
+		}
+	}
+
+	return results, errors.Join(errs...)
+}
+
+func (s *Scheduler) runRegion(region string, parallelism int, allowFailures bool) map[string]error {
+	type result struct {
+		name string
+		err  error
+	}
+
+	// Number of each sweeper's dependencies that have not yet completed.
+	remaining := make(map[string]int, len(s.order))
+	var ready []string
+	for _, name := range s.order {
+		dependencies, _ := s.graph.DirectDependenciesOf(name)
+		remaining[name] = len(dependencies)
+		if len(dependencies) == 0 {
+			ready = append(ready, name)
+		}
+	}
+
+	results := make(map[string]error, len(s.order))
+	done := make(chan result)
+	var running int
+	var failed bool
+
+	for {
+		for !failed && len(ready) > 0 && running < parallelism {
+			name := ready[0]
+			ready = ready[1:]
+			running++
+
+			go func() {
+				done <- result{name: name, err: s.runSweeper(region, name)}
+			}()
+		}
+
+		if running == 0 {
+			break
+		}
+
+		r := <-done
+		running--
+		results[r.name] = r.err
+
+		if r.err != nil && !allowFailures {
+			failed = true
+			continue
+		}
+
+		dependents, _ := s.graph.DirectDependentsOf(r.name)
+		for _, dependent := range dependents {
+			remaining[dependent]--
+			if remaining[dependent] == 0 {
+				ready = append(ready, dependent)
+			}
+		}
+	}
+
+	return results
+}
+
+func (s *Scheduler) runSweeper(region, name string) error {
+	ctx := WithResourceType(Context(region), name)
+
+	if reason, skip := unorchestratedSkipReason(name); skip {
+		tflog.Warn(ctx, "Skipping sweeper", map[string]any{
+			"reason": reason,
+		})
+		options.report.add(ctx, Resource{}, reportActionSkipped, reason, nil)
+
+		return nil
+	}
+
+	tflog.Debug(ctx, "Running sweeper")
+	start := time.Now()
+
+	err := s.sweepers[name].F(region)
+
+	fields := map[string]any{
+		"duration": time.Since(start).String(),
+	}
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.Error(ctx, "Sweeper failed", fields)
+	} else {
+		tflog.Info(ctx, "Sweeper completed", fields)
+	}
+
+	return err
+}
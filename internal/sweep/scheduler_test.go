@@ -0,0 +1,368 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func testSweepers(dependencies map[string][]string, f func(name, region string) error) map[string]*resource.Sweeper {
+	sweepers := make(map[string]*resource.Sweeper)
+	for name, dependencies := range dependencies {
+		sweepers[name] = &resource.Sweeper{
+			Name:         name,
+			Dependencies: dependencies,
+			F: func(region string) error {
+				return f(name, region)
+			},
+		}
+	}
+
+	return sweepers
+}
+
+func noopSweeper(string, string) error {
+	return nil
+}
+
+func TestNewScheduler(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		dependencies  map[string][]string
+		expectedOrder []string
+		expectedError string
+	}{
+		"empty": {
+			dependencies:  map[string][]string{},
+			expectedOrder: []string{},
+		},
+		"no dependencies": {
+			dependencies: map[string][]string{
+				"aws_b": nil,
+				"aws_a": nil,
+			},
+			expectedOrder: []string{"aws_a", "aws_b"},
+		},
+		"dependencies": {
+			dependencies: map[string][]string{
+				"aws_vpc":            {"aws_subnet", "aws_security_group"},
+				"aws_subnet":         {"aws_instance"},
+				"aws_security_group": {"aws_instance"},
+				"aws_instance":       nil,
+			},
+			expectedOrder: []string{"aws_instance", "aws_subnet", "aws_security_group", "aws_vpc"},
+		},
+		"missing dependency": {
+			dependencies: map[string][]string{
+				"aws_vpc":    {"aws_subnet", "aws_missing"},
+				"aws_subnet": {"aws_other_missing"},
+			},
+			expectedError: "sweeper (aws_subnet) has dependency (aws_other_missing), but that sweeper was not found\nsweeper (aws_vpc) has dependency (aws_missing), but that sweeper was not found",
+		},
+		"cycle": {
+			dependencies: map[string][]string{
+				"aws_a": {"aws_b"},
+				"aws_b": {"aws_c"},
+				"aws_c": {"aws_a"},
+			},
+			expectedError: "sweepers: dependency cycle: aws_a -> aws_b -> aws_c -> aws_a",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			scheduler, err := NewScheduler(testSweepers(testCase.dependencies, noopSweeper))
+
+			if testCase.expectedError != "" {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+				if got, want := err.Error(), testCase.expectedError; got != want {
+					t.Fatalf("error = %q, want %q", got, want)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(scheduler.Order(), testCase.expectedOrder); diff != "" {
+				t.Errorf("unexpected order diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestSchedulerFilter(t *testing.T) {
+	t.Parallel()
+
+	scheduler, err := NewScheduler(testSweepers(map[string][]string{
+		"aws_vpc":            {"aws_subnet"},
+		"aws_subnet":         {"aws_instance"},
+		"aws_instance":       nil,
+		"aws_s3_bucket":      nil,
+		"aws_security_group": nil,
+	}, noopSweeper))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := map[string]struct {
+		filter        string
+		expectedOrder []string
+	}{
+		"empty": {
+			filter:        "",
+			expectedOrder: []string{"aws_s3_bucket", "aws_security_group", "aws_instance", "aws_subnet", "aws_vpc"},
+		},
+		"exact": {
+			filter:        "aws_s3_bucket",
+			expectedOrder: []string{"aws_s3_bucket"},
+		},
+		"with dependencies": {
+			filter:        "aws_vpc",
+			expectedOrder: []string{"aws_instance", "aws_subnet", "aws_vpc"},
+		},
+		"substring case-insensitive": {
+			filter:        "AWS_S",
+			expectedOrder: []string{"aws_s3_bucket", "aws_security_group", "aws_instance", "aws_subnet"},
+		},
+		"multiple": {
+			filter:        "bucket,instance",
+			expectedOrder: []string{"aws_instance", "aws_s3_bucket"},
+		},
+		"no match": {
+			filter:        "aws_nat_gateway",
+			expectedOrder: []string{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			filtered, err := scheduler.Filter(testCase.filter)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(filtered.Order(), testCase.expectedOrder); diff != "" {
+				t.Errorf("unexpected order diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestSchedulerPlan(t *testing.T) {
+	t.Parallel()
+
+	scheduler, err := NewScheduler(testSweepers(map[string][]string{
+		"aws_vpc":            {"aws_subnet", "aws_security_group"},
+		"aws_subnet":         {"aws_instance"},
+		"aws_security_group": {"aws_instance"},
+		"aws_instance":       nil,
+		"aws_s3_bucket":      nil,
+	}, noopSweeper))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var b strings.Builder
+	if err := scheduler.Plan(&b, []string{"us-west-2", "us-east-1"}, nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := `Sweeper plan for region(s) us-west-2, us-east-1: 5 sweeper(s) in 3 stage(s)
+
+Stage 1:
+  - aws_instance
+  - aws_s3_bucket
+
+Stage 2:
+  - aws_security_group (after aws_instance)
+  - aws_subnet (after aws_instance)
+
+Stage 3:
+  - aws_vpc (after aws_subnet, aws_security_group)
+`
+
+	if diff := cmp.Diff(b.String(), expected); diff != "" {
+		t.Errorf("unexpected plan diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestSchedulerPlan_candidates(t *testing.T) {
+	t.Parallel()
+
+	scheduler, err := NewScheduler(testSweepers(map[string][]string{
+		"aws_vpc":    {"aws_subnet"},
+		"aws_subnet": nil,
+	}, noopSweeper))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	candidates := newReport()
+	ctx := WithResourceType(Context("us-west-2"), "aws_subnet")
+	candidates.add(ctx, Resource{ID: "subnet-2"}, reportActionSkipped, "name does not match prefix", nil)
+	candidates.add(ctx, Resource{ID: "subnet-1", Name: "tf-acc-test"}, reportActionWouldDelete, "", nil)
+	ctx = WithResourceType(Context("us-west-2"), "aws_vpc")
+	candidates.add(ctx, Resource{}, reportActionSkipped, "sweeper does not support -sweep-dry-run or sweep filters", nil)
+
+	var b strings.Builder
+	if err := scheduler.Plan(&b, []string{"us-west-2"}, candidates); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := `Sweeper plan for region(s) us-west-2: 2 sweeper(s) in 2 stage(s)
+
+Stage 1:
+  - aws_subnet
+      us-west-2: subnet-1 (tf-acc-test) would_delete
+      us-west-2: subnet-2 skipped (name does not match prefix)
+
+Stage 2:
+  - aws_vpc (after aws_subnet)
+      us-west-2: skipped (sweeper does not support -sweep-dry-run or sweep filters)
+`
+
+	if diff := cmp.Diff(b.String(), expected); diff != "" {
+		t.Errorf("unexpected plan diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestSchedulerRun(t *testing.T) {
+	t.Parallel()
+
+	dependencies := map[string][]string{
+		"aws_vpc":            {"aws_subnet", "aws_security_group"},
+		"aws_subnet":         {"aws_instance"},
+		"aws_security_group": {"aws_instance"},
+		"aws_instance":       nil,
+		"aws_s3_bucket":      nil,
+		"aws_sqs_queue":      nil,
+	}
+
+	var mu sync.Mutex
+	var inflight, maxInflight atomic.Int32
+	completed := make(map[string][]string)
+
+	scheduler, err := NewScheduler(testSweepers(dependencies, func(name, region string) error {
+		n := inflight.Add(1)
+		defer inflight.Add(-1)
+		for {
+			if v := maxInflight.Load(); n <= v || maxInflight.CompareAndSwap(v, n) {
+				break
+			}
+		}
+
+		mu.Lock()
+		for _, dependency := range dependencies[name] {
+			if !slices.Contains(completed[region], dependency) {
+				t.Errorf("sweeper (%s) ran before its dependency (%s) in region (%s)", name, dependency, region)
+			}
+		}
+		mu.Unlock()
+
+		time.Sleep(10 * time.Millisecond)
+
+		mu.Lock()
+		completed[region] = append(completed[region], name)
+		mu.Unlock()
+
+		return nil
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	results, err := scheduler.Run([]string{"us-west-2", "us-east-1"}, 2, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, region := range []string{"us-west-2", "us-east-1"} {
+		if got, want := len(results[region]), len(dependencies); got != want {
+			t.Errorf("%s: ran %d sweepers, want %d", region, got, want)
+		}
+	}
+
+	if got := maxInflight.Load(); got > 2 {
+		t.Errorf("ran %d sweepers concurrently, want at most 2", got)
+	}
+}
+
+func TestSchedulerRun_failure(t *testing.T) {
+	t.Parallel()
+
+	dependencies := map[string][]string{
+		"aws_vpc":      {"aws_subnet"},
+		"aws_subnet":   {"aws_instance"},
+		"aws_instance": nil,
+	}
+
+	testCases := map[string]struct {
+		allowFailures bool
+		expectedRan   []string
+	}{
+		"stop": {
+			allowFailures: false,
+			expectedRan:   []string{"aws_instance", "aws_subnet"},
+		},
+		"allow failures": {
+			allowFailures: true,
+			expectedRan:   []string{"aws_instance", "aws_subnet", "aws_vpc"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			scheduler, err := NewScheduler(testSweepers(dependencies, func(name, region string) error {
+				if name == "aws_subnet" {
+					return errors.New("boom")
+				}
+				return nil
+			}))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			results, err := scheduler.Run([]string{"us-west-2", "us-east-1"}, 1, testCase.allowFailures)
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+			if got, want := err.Error(), "sweeper (aws_subnet) for region (us-west-2) failed: boom"; !strings.HasPrefix(got, want) {
+				t.Errorf("error = %q, want prefix %q", got, want)
+			}
+
+			var ran []string
+			for _, name := range scheduler.Order() {
+				if _, ok := results["us-west-2"][name]; ok {
+					ran = append(ran, name)
+				}
+			}
+			if diff := cmp.Diff(ran, testCase.expectedRan); diff != "" {
+				t.Errorf("unexpected sweepers diff (+wanted, -got): %s", diff)
+			}
+
+			if _, ok := results["us-east-1"]; ok != testCase.allowFailures {
+				t.Errorf("us-east-1 ran = %t, want %t", ok, testCase.allowFailures)
+			}
+		})
+	}
+}
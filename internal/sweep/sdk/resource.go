@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/filter"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -39,6 +40,21 @@ func (sr *sweepResource) Delete(ctx context.Context, optFns ...tfresource.Option
 	return deleteResource(ctx, sr.resource, sr.d, sr.meta)
 }
 
+// Describe returns a description of the resource built from the attributes set by the sweeper.
+func (sr *sweepResource) Describe(context.Context) filter.Resource {
+	schema := sr.resource.SchemaMap()
+	r := filter.FromAttributes(func(k string) (any, bool) {
+		if _, ok := schema[k]; !ok {
+			return nil, false
+		}
+
+		return sr.d.GetOk(k)
+	})
+	r.ID = sr.d.Id()
+
+	return r
+}
+
 type readerSweepResource struct {
 	sweepResource
 }
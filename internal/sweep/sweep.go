@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"sync"
 	"time"
 
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
@@ -29,10 +30,17 @@ var ServicePackages []conns.ServicePackage
 
 // sweeperClients is a shared cache of regional conns.AWSClient
 // This prevents client re-initialization for every resource with no benefit.
-var sweeperClients map[string]*conns.AWSClient = make(map[string]*conns.AWSClient)
+var (
+	sweeperClients      map[string]*conns.AWSClient = make(map[string]*conns.AWSClient)
+	sweeperClientsMutex sync.Mutex
+)
 
 // SharedRegionalSweepClient returns a common conns.AWSClient setup needed for the sweeper functions for a given Region.
+// It is safe for concurrent use by sweepers running in parallel.
 func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSClient, error) {
+	sweeperClientsMutex.Lock()
+	defer sweeperClientsMutex.Unlock()
+
 	if client, ok := sweeperClients[region]; ok {
 		return client, nil
 	}
@@ -104,16 +112,20 @@ type Sweepable interface {
 	Delete(ctx context.Context, optFns ...tfresource.OptionsFunc) error
 }
 
+// SweepOrchestrator deletes the specified resources concurrently.
+// Resources that don't match the configured filter are skipped and in dry-run mode no resources are deleted.
 func SweepOrchestrator(ctx context.Context, sweepables []Sweepable, optFns ...tfresource.OptionsFunc) error {
-	if len(sweepables) == 0 {
+	candidates := filterSweepables(ctx, sweepables)
+
+	if len(candidates) == 0 {
 		tflog.Info(ctx, "No resources to sweep")
 	}
 
 	var g multierror.Group
 
-	for _, sweepable := range sweepables {
+	for _, candidate := range candidates {
 		g.Go(func() error {
-			return sweepable.Delete(ctx, optFns...)
+			return deleteCandidate(ctx, candidate, optFns...)
 		})
 	}
 
@@ -53,6 +53,10 @@ const (
 	// Valid values are "error", "warning", and "disabled". Any other value will trigger an error
 	// during provider initialization.
 	TagPolicyComplianceEnvVar = "TF_AWS_TAG_POLICY_COMPLIANCE"
+
+	// Environment variable specifying the path to a local JSON file containing the tag policy
+	// to enforce when organizational tag policies are enforced
+	TagPolicyFileEnvVar = "TF_AWS_TAG_POLICY_FILE"
 )
 
 // DefaultConfig contains tags to default across all resources.
@@ -78,6 +82,9 @@ type TagPolicyConfig struct {
 	// RequiredTags is a mapping of Terraform resource type names to the required
 	// tags defined in the effective tag policy
 	RequiredTags map[string]KeyValueTags
+
+	// Rules are the tag key rules defined in the effective tag policy
+	Rules []TagPolicyRule
 }
 
 // KeyValueTags is a standard implementation for AWS key-value resource tags.
@@ -0,0 +1,118 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// TagPolicyRule is a tag key rule defined in an organizational tag policy.
+type TagPolicyRule struct {
+	// Key is the tag key with the capitalization required by the policy.
+	Key string
+
+	// Values are the allowed tag values. A value ending in "*" matches any
+	// suffix. An empty list allows any value.
+	Values []string
+
+	// EnforcedFor is the list of Terraform resource types for which
+	// noncompliant tagging operations are prevented.
+	EnforcedFor []string
+
+	// RequiredFor is the list of Terraform resource types for which the tag
+	// is reported as required.
+	RequiredFor []string
+}
+
+// IsEnforcedFor returns whether the rule is enforced for the specified Terraform resource type.
+func (r TagPolicyRule) IsEnforcedFor(typeName string) bool {
+	return slices.Contains(r.EnforcedFor, typeName)
+}
+
+// IsRequiredFor returns whether the tag is required for the specified Terraform resource type.
+func (r TagPolicyRule) IsRequiredFor(typeName string) bool {
+	return slices.Contains(r.RequiredFor, typeName)
+}
+
+// AllowsValue returns whether the rule allows the specified tag value.
+func (r TagPolicyRule) AllowsValue(value string) bool {
+	if len(r.Values) == 0 {
+		return true
+	}
+
+	for _, v := range r.Values {
+		if prefix, ok := strings.CutSuffix(v, "*"); ok {
+			if strings.HasPrefix(value, prefix) {
+				return true
+			}
+		} else if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// TagPolicyViolation describes a tag which does not comply with a tag policy rule.
+type TagPolicyViolation struct {
+	// Key is the offending tag key as configured.
+	Key string
+
+	// Value is the offending tag value as configured.
+	Value string
+
+	// Rule is the tag policy rule which is violated.
+	Rule TagPolicyRule
+
+	// Enforced indicates whether the rule is enforced for the resource type.
+	Enforced bool
+}
+
+func (v TagPolicyViolation) String() string {
+	if v.Key != v.Rule.Key {
+		return fmt.Sprintf("tag key %q must be capitalized as %q", v.Key, v.Rule.Key)
+	}
+
+	return fmt.Sprintf("tag %q has value %q, allowed values: %q", v.Key, v.Value, v.Rule.Values)
+}
+
+// Violations returns the tags which do not comply with the tag policy rules for the specified Terraform resource type.
+// Tag keys are matched to rules case-insensitively.
+// Violations are sorted by tag key.
+func (c *TagPolicyConfig) Violations(typeName string, tags KeyValueTags) []TagPolicyViolation {
+	if c == nil {
+		return nil
+	}
+
+	var violations []TagPolicyViolation
+	for _, k := range tags.Keys() {
+		value := tags.KeyValue(k)
+		if value == nil {
+			value = new(string)
+		}
+
+		for _, rule := range c.Rules {
+			if !strings.EqualFold(k, rule.Key) {
+				continue
+			}
+
+			if k != rule.Key || !rule.AllowsValue(*value) {
+				violations = append(violations, TagPolicyViolation{
+					Key:      k,
+					Value:    *value,
+					Rule:     rule,
+					Enforced: rule.IsEnforcedFor(typeName),
+				})
+			}
+		}
+	}
+
+	slices.SortFunc(violations, func(a, b TagPolicyViolation) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+
+	return violations
+}
@@ -0,0 +1,170 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestTagPolicyRuleAllowsValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		values []string
+		value  string
+		want   bool
+	}{
+		"no values": {
+			value: "anything",
+			want:  true,
+		},
+		"exact match": {
+			values: []string{"100", "200"},
+			value:  "200",
+			want:   true,
+		},
+		"no match": {
+			values: []string{"100", "200"},
+			value:  "300",
+		},
+		"case mismatch": {
+			values: []string{"Production"},
+			value:  "production",
+		},
+		"wildcard match": {
+			values: []string{"Dev*"},
+			value:  "Development",
+			want:   true,
+		},
+		"wildcard no match": {
+			values: []string{"Dev*"},
+			value:  "Test",
+		},
+		"wildcard only": {
+			values: []string{"*"},
+			value:  "",
+			want:   true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			rule := TagPolicyRule{Key: "CostCenter", Values: testCase.values}
+			if got, want := rule.AllowsValue(testCase.value), testCase.want; got != want {
+				t.Errorf("AllowsValue(%q) = %t, want %t", testCase.value, got, want)
+			}
+		})
+	}
+}
+
+func TestTagPolicyConfigViolations(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	costCenter := TagPolicyRule{
+		Key:         "CostCenter",
+		Values:      []string{"100", "200"},
+		EnforcedFor: []string{"aws_instance"},
+	}
+	environment := TagPolicyRule{
+		Key:    "Environment",
+		Values: []string{"Prod*", "Test"},
+	}
+	config := &TagPolicyConfig{
+		Severity: "error",
+		Rules:    []TagPolicyRule{costCenter, environment},
+	}
+
+	testCases := map[string]struct {
+		config   *TagPolicyConfig
+		typeName string
+		tags     map[string]string
+		want     []TagPolicyViolation
+	}{
+		"nil config": {
+			typeName: "aws_instance",
+			tags:     map[string]string{"costcenter": "999"},
+		},
+		"compliant": {
+			config:   config,
+			typeName: "aws_instance",
+			tags:     map[string]string{"CostCenter": "100", "Environment": "Production", "Name": "test"},
+		},
+		"invalid value enforced": {
+			config:   config,
+			typeName: "aws_instance",
+			tags:     map[string]string{"CostCenter": "999"},
+			want: []TagPolicyViolation{
+				{Key: "CostCenter", Value: "999", Rule: costCenter, Enforced: true},
+			},
+		},
+		"invalid value not enforced": {
+			config:   config,
+			typeName: "aws_s3_bucket",
+			tags:     map[string]string{"CostCenter": "999"},
+			want: []TagPolicyViolation{
+				{Key: "CostCenter", Value: "999", Rule: costCenter},
+			},
+		},
+		"key capitalization": {
+			config:   config,
+			typeName: "aws_instance",
+			tags:     map[string]string{"costcenter": "100", "ENVIRONMENT": "Test"},
+			want: []TagPolicyViolation{
+				{Key: "ENVIRONMENT", Value: "Test", Rule: environment},
+				{Key: "costcenter", Value: "100", Rule: costCenter, Enforced: true},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.config.Violations(testCase.typeName, New(ctx, testCase.tags))
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestTagPolicyViolationString(t *testing.T) {
+	t.Parallel()
+
+	rule := TagPolicyRule{
+		Key:    "CostCenter",
+		Values: []string{"100", "200"},
+	}
+
+	testCases := map[string]struct {
+		violation TagPolicyViolation
+		want      string
+	}{
+		"capitalization": {
+			violation: TagPolicyViolation{Key: "costcenter", Value: "100", Rule: rule},
+			want:      `tag key "costcenter" must be capitalized as "CostCenter"`,
+		},
+		"value": {
+			violation: TagPolicyViolation{Key: "CostCenter", Value: "999", Rule: rule},
+			want:      `tag "CostCenter" has value "999", allowed values: ["100" "200"]`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := testCase.violation.String(), testCase.want; got != want {
+				t.Errorf("String() = %q, want %q", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,185 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tagpolicy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// allSupported is the tag policy resource type matching every supported resource type of a service.
+const allSupported = "ALL_SUPPORTED"
+
+func GetEffectiveTagPolicy(ctx context.Context, awsConfig aws.Config) ([]tftags.TagPolicyRule, error) {
+	return FindEffectiveTagPolicy(ctx, organizations.NewFromConfig(awsConfig))
+}
+
+// FindEffectiveTagPolicy returns the tag key rules of the effective tag policy
+// for the calling account. No rules are returned if no tag policy applies.
+func FindEffectiveTagPolicy(ctx context.Context, conn *organizations.Client) ([]tftags.TagPolicyRule, error) {
+	input := organizations.DescribeEffectivePolicyInput{
+		PolicyType: awstypes.EffectivePolicyTypeTagPolicy,
+	}
+	output, err := conn.DescribeEffectivePolicy(ctx, &input)
+
+	if errs.IsA[*awstypes.EffectivePolicyNotFoundException](err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.EffectivePolicy == nil {
+		return nil, nil
+	}
+
+	return ParsePolicy(aws.ToString(output.EffectivePolicy.PolicyContent))
+}
+
+// Tag policy inheritance operators. See
+// https://docs.aws.amazon.com/organizations/latest/userguide/policy-operators.html.
+const (
+	operatorAssign                   = "@@assign"
+	operatorOperatorsAllowedForChild = "@@operators_allowed_for_child_policies"
+	operatorPrefix                   = "@@"
+)
+
+// policyDocument is the JSON representation of a tag policy.
+type policyDocument struct {
+	Tags map[string]json.RawMessage `json:"tags"`
+}
+
+type policyTag struct {
+	TagKey               policyValue[string]   `json:"tag_key"`
+	TagValue             policyValue[[]string] `json:"tag_value"`
+	EnforcedFor          policyValue[[]string] `json:"enforced_for"`
+	ReportRequiredTagFor policyValue[[]string] `json:"report_required_tag_for"`
+}
+
+// policyValue is a tag policy value. Effective policies contain plain values,
+// while policies written in the tag policy syntax wrap values in an "@@assign"
+// operator. Other value-setting operators, such as "@@append" and "@@remove",
+// modify the value inherited from a parent policy, which a single policy
+// doesn't have, so they are rejected.
+type policyValue[T any] struct {
+	value T
+}
+
+func (v *policyValue[T]) UnmarshalJSON(b []byte) error {
+	var operators map[string]json.RawMessage
+	if err := json.Unmarshal(b, &operators); err != nil {
+		return json.Unmarshal(b, &v.value)
+	}
+
+	for _, k := range slices.Sorted(maps.Keys(operators)) {
+		switch k {
+		case operatorAssign:
+			if err := json.Unmarshal(operators[k], &v.value); err != nil {
+				return err
+			}
+		case operatorOperatorsAllowedForChild:
+			// Only restricts the operators that child policies can use.
+		default:
+			if strings.HasPrefix(k, operatorPrefix) {
+				return fmt.Errorf("unsupported tag policy operator %q: only %q is supported", k, operatorAssign)
+			}
+			return json.Unmarshal(b, &v.value)
+		}
+	}
+
+	return nil
+}
+
+// ParsePolicy translates the content of an effective tag policy, or of a tag
+// policy written in the tag policy syntax, into tag key rules. Tag policy
+// resource types are mapped to Terraform resource types.
+// Rules are sorted by key.
+func ParsePolicy(content string) ([]tftags.TagPolicyRule, error) {
+	var doc policyDocument
+	if err := json.Unmarshal([]byte(content), &doc); err != nil {
+		return nil, err
+	}
+
+	rules := make([]tftags.TagPolicyRule, 0, len(doc.Tags))
+	for _, k := range slices.Sorted(maps.Keys(doc.Tags)) {
+		if strings.HasPrefix(k, operatorPrefix) {
+			// e.g. "@@operators_allowed_for_child_policies" applying to all tags.
+			continue
+		}
+
+		var v policyTag
+		if err := json.Unmarshal(doc.Tags[k], &v); err != nil {
+			return nil, fmt.Errorf("tag policy key %q: %w", k, err)
+		}
+
+		key := v.TagKey.value
+		if key == "" {
+			key = k
+		}
+
+		rules = append(rules, tftags.TagPolicyRule{
+			Key:         key,
+			Values:      v.TagValue.value,
+			EnforcedFor: terraformResourceTypes(v.EnforcedFor.value),
+			RequiredFor: terraformResourceTypes(v.ReportRequiredTagFor.value),
+		})
+	}
+
+	return rules, nil
+}
+
+// MergeRules merges two lists of tag key rules. Rules are matched by case-insensitive
+// tag key, and a rule in overrides replaces the matching rule in rules.
+// Rules are sorted by key.
+func MergeRules(rules, overrides []tftags.TagPolicyRule) []tftags.TagPolicyRule {
+	m := make(map[string]tftags.TagPolicyRule, len(rules)+len(overrides))
+	for _, rule := range rules {
+		m[strings.ToLower(rule.Key)] = rule
+	}
+	for _, rule := range overrides {
+		m[strings.ToLower(rule.Key)] = rule
+	}
+
+	merged := make([]tftags.TagPolicyRule, 0, len(m))
+	for _, k := range slices.Sorted(maps.Keys(m)) {
+		merged = append(merged, m[k])
+	}
+
+	return merged
+}
+
+// terraformResourceTypes maps tag policy resource types to Terraform resource types.
+// Resource types without a Terraform equivalent are ignored.
+func terraformResourceTypes(policyTypes []string) []string {
+	var tfTypes []string
+	for _, policyType := range policyTypes {
+		if service, ok := strings.CutSuffix(policyType, ":"+allSupported); ok {
+			for _, k := range slices.Sorted(maps.Keys(Lookup)) {
+				if strings.HasPrefix(k, service+":") {
+					tfTypes = append(tfTypes, Lookup[k])
+				}
+			}
+			continue
+		}
+
+		if tfType, ok := Lookup[policyType]; ok {
+			tfTypes = append(tfTypes, tfType)
+		}
+	}
+
+	slices.Sort(tfTypes)
+
+	return slices.Compact(tfTypes)
+}
@@ -0,0 +1,204 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tagpolicy
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func TestParsePolicy(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		content     string
+		want        []tftags.TagPolicyRule
+		expectError bool
+	}{
+		"empty": {
+			content: `{}`,
+			want:    []tftags.TagPolicyRule{},
+		},
+		"invalid JSON": {
+			content:     `{`,
+			expectError: true,
+		},
+		"rules": {
+			content: `{
+  "tags": {
+    "environment": {
+      "tag_value": ["Prod*", "Test"]
+    },
+    "costcenter": {
+      "tag_key": "CostCenter",
+      "tag_value": ["100", "200"],
+      "enforced_for": ["xray:group", "acm:certificate", "unknown:type"],
+      "report_required_tag_for": ["acm:certificate"]
+    }
+  }
+}`,
+			want: []tftags.TagPolicyRule{
+				{
+					Key:         "CostCenter",
+					Values:      []string{"100", "200"},
+					EnforcedFor: []string{"aws_acm_certificate", "aws_xray_group"},
+					RequiredFor: []string{"aws_acm_certificate"},
+				},
+				{
+					Key:    "environment",
+					Values: []string{"Prod*", "Test"},
+				},
+			},
+		},
+		"policy syntax": {
+			content: `{
+  "tags": {
+    "costcenter": {
+      "tag_key": {
+        "@@assign": "CostCenter"
+      },
+      "tag_value": {
+        "@@assign": ["100", "200"]
+      },
+      "enforced_for": {
+        "@@assign": ["acm:certificate"]
+      },
+      "report_required_tag_for": {
+        "@@assign": ["acm:certificate", "xray:group"]
+      }
+    }
+  }
+}`,
+			want: []tftags.TagPolicyRule{
+				{
+					Key:         "CostCenter",
+					Values:      []string{"100", "200"},
+					EnforcedFor: []string{"aws_acm_certificate"},
+					RequiredFor: []string{"aws_acm_certificate", "aws_xray_group"},
+				},
+			},
+		},
+		"all supported": {
+			content: `{
+  "tags": {
+    "owner": {
+      "tag_key": "Owner",
+      "enforced_for": ["xray:ALL_SUPPORTED", "xray:group"]
+    }
+  }
+}`,
+			want: []tftags.TagPolicyRule{
+				{
+					Key:         "Owner",
+					EnforcedFor: []string{"aws_xray_group", "aws_xray_sampling_rule"},
+				},
+			},
+		},
+		"operators allowed for child policies": {
+			content: `{
+  "tags": {
+    "@@operators_allowed_for_child_policies": ["@@none"],
+    "owner": {
+      "tag_key": {
+        "@@assign": "Owner",
+        "@@operators_allowed_for_child_policies": ["@@none"]
+      }
+    }
+  }
+}`,
+			want: []tftags.TagPolicyRule{
+				{
+					Key: "Owner",
+				},
+			},
+		},
+		"append operator": {
+			content: `{
+  "tags": {
+    "owner": {
+      "tag_value": {
+        "@@append": ["Ops"]
+      }
+    }
+  }
+}`,
+			expectError: true,
+		},
+		"remove operator": {
+			content: `{
+  "tags": {
+    "owner": {
+      "enforced_for": {
+        "@@remove": ["acm:certificate"]
+      }
+    }
+  }
+}`,
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParsePolicy(testCase.content)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("ParsePolicy() err %t, want %t: %v", got, want, err)
+			}
+			if err != nil {
+				return
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestMergeRules(t *testing.T) {
+	t.Parallel()
+
+	rules := []tftags.TagPolicyRule{
+		{
+			Key:    "CostCenter",
+			Values: []string{"100"},
+		},
+		{
+			Key:         "Owner",
+			EnforcedFor: []string{"aws_acm_certificate"},
+		},
+	}
+	overrides := []tftags.TagPolicyRule{
+		{
+			Key:    "costcenter",
+			Values: []string{"200"},
+		},
+		{
+			Key: "Environment",
+		},
+	}
+
+	got := MergeRules(rules, overrides)
+	want := []tftags.TagPolicyRule{
+		{
+			Key:    "costcenter",
+			Values: []string{"200"},
+		},
+		{
+			Key: "Environment",
+		},
+		{
+			Key:         "Owner",
+			EnforcedFor: []string{"aws_acm_certificate"},
+		},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tagpolicy
+
+import (
+	"context"
+	"maps"
+	"os"
+
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// ReadPolicyFile reads a tag policy from a local JSON file. The file may contain
+// either an effective tag policy or a tag policy written in the tag policy syntax.
+// The returned required tags are derived from the "report_required_tag_for"
+// resource types of each tag key rule.
+func ReadPolicyFile(ctx context.Context, path string) (map[string]tftags.KeyValueTags, []tftags.TagPolicyRule, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rules, err := ParsePolicy(string(content))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return requiredTags(ctx, rules), rules, nil
+}
+
+// MergeRequiredTags merges two mappings of Terraform resource type names to
+// required tags. Required tags for resource types present in both are combined.
+func MergeRequiredTags(a, b map[string]tftags.KeyValueTags) map[string]tftags.KeyValueTags {
+	m := maps.Clone(a)
+	if m == nil {
+		m = make(map[string]tftags.KeyValueTags, len(b))
+	}
+
+	for tfType, tags := range b {
+		if v, ok := m[tfType]; ok {
+			m[tfType] = v.Merge(tags)
+		} else {
+			m[tfType] = tags
+		}
+	}
+
+	return m
+}
+
+// requiredTags translates tag key rules into a map of required tags per
+// Terraform resource type
+func requiredTags(ctx context.Context, rules []tftags.TagPolicyRule) map[string]tftags.KeyValueTags {
+	m := make(map[string]tftags.KeyValueTags)
+	for _, rule := range rules {
+		newTags := tftags.New(ctx, []string{rule.Key})
+		for _, tfType := range rule.RequiredFor {
+			if v, ok := m[tfType]; ok {
+				m[tfType] = v.Merge(newTags)
+			} else {
+				m[tfType] = newTags
+			}
+		}
+	}
+	return m
+}
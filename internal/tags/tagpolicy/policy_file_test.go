@@ -0,0 +1,103 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tagpolicy
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func TestReadPolicyFile(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	dir := t.TempDir()
+
+	path := filepath.Join(dir, "policy.json")
+	content := `{
+  "tags": {
+    "owner": {
+      "tag_key": {
+        "@@assign": "Owner"
+      },
+      "report_required_tag_for": {
+        "@@assign": ["acm:certificate", "xray:group"]
+      }
+    },
+    "costcenter": {
+      "tag_key": {
+        "@@assign": "CostCenter"
+      },
+      "report_required_tag_for": {
+        "@@assign": ["acm:certificate"]
+      }
+    }
+  }
+}`
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	reqTags, rules, err := ReadPolicyFile(ctx, path)
+	if err != nil {
+		t.Fatalf("ReadPolicyFile() err: %v", err)
+	}
+
+	if got, want := len(rules), 2; got != want {
+		t.Errorf("len(rules) = %d, want %d", got, want)
+	}
+
+	got := make(map[string][]string, len(reqTags))
+	for k, v := range reqTags {
+		got[k] = v.Keys()
+		slices.Sort(got[k])
+	}
+	want := map[string][]string{
+		"aws_acm_certificate": {"CostCenter", "Owner"},
+		"aws_xray_group":      {"Owner"},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	if _, _, err := ReadPolicyFile(ctx, filepath.Join(dir, "missing.json")); err == nil {
+		t.Error("ReadPolicyFile() expected error for missing file")
+	}
+}
+
+func TestMergeRequiredTags(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+
+	a := map[string]tftags.KeyValueTags{
+		"aws_acm_certificate": tftags.New(ctx, []string{"Owner"}),
+	}
+	b := map[string]tftags.KeyValueTags{
+		"aws_acm_certificate": tftags.New(ctx, []string{"CostCenter"}),
+		"aws_xray_group":      tftags.New(ctx, []string{"Owner"}),
+	}
+
+	got := make(map[string][]string)
+	for k, v := range MergeRequiredTags(a, b) {
+		got[k] = v.Keys()
+		slices.Sort(got[k])
+	}
+	want := map[string][]string{
+		"aws_acm_certificate": {"CostCenter", "Owner"},
+		"aws_xray_group":      {"Owner"},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	if got, want := a["aws_acm_certificate"].Keys(), []string{"Owner"}; !cmp.Equal(got, want) {
+		t.Errorf("input modified: got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"maps"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// DecodeForm decodes AWS Query or EC2 protocol request parameters.
+// Dotted parameter names are expanded into nested maps, and numbered members ("Name.member.N", "Name.entry.N"
+// and "Name.N") are gathered into lists ordered by index.
+// For example, "Filter.1.Name=a&Filter.1.Value.1=b" decodes to {"Filter": [{"Name": "a", "Value": ["b"]}]}.
+func DecodeForm(values url.Values) map[string]any {
+	tree := make(map[string]any)
+	for k, v := range values {
+		var value any
+		if len(v) == 1 {
+			value = v[0]
+		} else {
+			value = v
+		}
+
+		insertFormValue(tree, strings.Split(k, "."), value)
+	}
+
+	return formLists(tree).(map[string]any)
+}
+
+func insertFormValue(tree map[string]any, path []string, value any) {
+	for _, k := range path[:len(path)-1] {
+		switch v := tree[k].(type) {
+		case map[string]any:
+			tree = v
+		default:
+			m := make(map[string]any)
+			if v != nil {
+				// A value is present at both "A" and "A.B".
+				m[""] = v
+			}
+			tree[k] = m
+			tree = m
+		}
+	}
+
+	k := path[len(path)-1]
+	if m, ok := tree[k].(map[string]any); ok {
+		m[""] = value
+	} else {
+		tree[k] = value
+	}
+}
+
+func formLists(v any) any {
+	m, ok := v.(map[string]any)
+	if !ok {
+		return v
+	}
+
+	for k, v := range m {
+		m[k] = formLists(v)
+	}
+
+	// Query protocol lists ("Name.member.N") and maps ("Name.entry.N").
+	if len(m) == 1 {
+		for _, k := range []string{"member", "entry"} {
+			if l, ok := m[k].([]any); ok {
+				return l
+			}
+		}
+	}
+
+	// Numbered list members ("Name.N").
+	if len(m) == 0 {
+		return m
+	}
+	indices := make(map[int]any, len(m))
+	for k, v := range m {
+		n, err := strconv.Atoi(k)
+		if err != nil || n < 1 {
+			return m
+		}
+		indices[n] = v
+	}
+
+	l := make([]any, 0, len(indices))
+	for _, n := range slices.Sorted(maps.Keys(indices)) {
+		l = append(l, indices[n])
+	}
+
+	return l
+}
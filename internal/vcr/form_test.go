@@ -0,0 +1,68 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr_test
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
+)
+
+func TestDecodeForm(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		query string
+		want  map[string]any
+	}{
+		"empty": {
+			want: map[string]any{},
+		},
+		"scalars": {
+			query: "A=x&B.C=y",
+			want: map[string]any{
+				"A": "x",
+				"B": map[string]any{"C": "y"},
+			},
+		},
+		"member list in index order": {
+			query: "Names.member.2=b&Names.member.10=c&Names.member.1=a",
+			want: map[string]any{
+				"Names": []any{"a", "b", "c"},
+			},
+		},
+		"numbered list of structures": {
+			query: "Filter.2.Name=n2&Filter.1.Name=n1&Filter.1.Value.1=v1",
+			want: map[string]any{
+				"Filter": []any{
+					map[string]any{"Name": "n1", "Value": []any{"v1"}},
+					map[string]any{"Name": "n2"},
+				},
+			},
+		},
+		"map entries": {
+			query: "Tags.entry.1.key=k&Tags.entry.1.value=v",
+			want: map[string]any{
+				"Tags": []any{map[string]any{"key": "k", "value": "v"}},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			values, err := url.ParseQuery(testCase.query)
+			if err != nil {
+				t.Fatalf("ParseQuery() err: %v", err)
+			}
+
+			if diff := cmp.Diff(vcr.DecodeForm(values), testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,314 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"reflect"
+	"slices"
+	"strings"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/encoding/cbor"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+)
+
+// BodyNormalizer normalizes a decoded request body in place before it is compared with a recorded request body.
+// The body is a tree of map[string]any, []any and scalar values.
+// Normalizers are applied to both the live and the recorded request bodies.
+type BodyNormalizer func(operation string, body map[string]any)
+
+var bodyNormalizers map[string][]BodyNormalizer
+
+// RegisterBodyNormalizer registers a request body normalizer for the specified service.
+// serviceID is the AWS SDK for Go v2 service ID, e.g. names.EC2ServiceID.
+// Registration is expected to take place in a service package's test init function.
+func RegisterBodyNormalizer(serviceID string, f BodyNormalizer) {
+	if bodyNormalizers == nil {
+		bodyNormalizers = make(map[string][]BodyNormalizer)
+	}
+
+	bodyNormalizers[serviceID] = append(bodyNormalizers[serviceID], f)
+}
+
+// RemoveBodyFields returns a BodyNormalizer that removes the specified top-level fields, regardless of operation.
+// It is typically used to ignore volatile values such as client or idempotency tokens.
+func RemoveBodyFields(fields ...string) BodyNormalizer {
+	return func(_ string, body map[string]any) {
+		for _, field := range fields {
+			delete(body, field)
+		}
+	}
+}
+
+// SortBodyLists returns a BodyNormalizer that sorts the lists at the specified paths, regardless of operation.
+// It is used for lists whose member order is not significant, such as EC2 filters.
+// A path is a dot-separated list of field names, e.g. "Filter.Value". Lists along a path are traversed member by member.
+// List members are sorted by their JSON encoding.
+func SortBodyLists(paths ...string) BodyNormalizer {
+	return func(_ string, body map[string]any) {
+		for _, path := range paths {
+			sortBodyList(body, strings.Split(path, "."))
+		}
+	}
+}
+
+func sortBodyList(v any, path []string) {
+	switch v := v.(type) {
+	case []any:
+		for _, e := range v {
+			sortBodyList(e, path)
+		}
+	case map[string]any:
+		e, ok := v[path[0]]
+		if !ok {
+			return
+		}
+
+		if len(path) > 1 {
+			sortBodyList(e, path[1:])
+			return
+		}
+
+		if l, ok := e.([]any); ok {
+			type member struct {
+				key   string
+				value any
+			}
+			members := make([]member, len(l))
+			for i, e := range l {
+				key, _ := tfjson.EncodeToString(e)
+				members[i] = member{key: key, value: e}
+			}
+			slices.SortFunc(members, func(a, b member) int {
+				return strings.Compare(a.key, b.key)
+			})
+			for i, m := range members {
+				l[i] = m.value
+			}
+		}
+	}
+}
+
+// RequestBodiesMatch returns whether the body of a live request is semantically equal to a recorded request body.
+//
+// Bodies are decoded according to the request's AWS protocol (https://smithy.io/2.0/aws/protocols/index.html)
+// and any redaction rules and normalizers registered for the request's service are applied before comparison.
+// Structure members are compared without regard to order. List members are compared in order,
+// unless a normalizer such as SortBodyLists is registered for the list.
+func RequestBodiesMatch(ctx context.Context, r *http.Request, body, recorded string) bool {
+	var decode func([]byte) (map[string]any, error)
+
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	switch mediaType {
+	case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
+		decode = decodeJSONBody
+
+	case "application/x-www-form-urlencoded":
+		decode = decodeFormBody
+
+	case "application/cbor":
+		decode = decodeCBORBody
+
+	case "application/xml", "text/xml":
+		decode = decodeXMLBody
+
+	default:
+		return false
+	}
+
+	serviceID, operation := awsmiddleware.GetServiceID(r.Context()), awsmiddleware.GetOperationName(r.Context())
+
+	// Sensitive values are redacted from recorded bodies.
+	if v, ok, err := redactBody(serviceID, mediaType, body); err == nil && ok {
+		body = v
+	}
+
+	v1, err := decode([]byte(body))
+	if err != nil {
+		tflog.Debug(ctx, "Failed to decode request body", map[string]any{
+			"content_type": mediaType,
+			"error":        err,
+		})
+		return false
+	}
+
+	v2, err := decode([]byte(recorded))
+	if err != nil {
+		tflog.Debug(ctx, "Failed to decode cassette body", map[string]any{
+			"content_type": mediaType,
+			"error":        err,
+		})
+		return false
+	}
+
+	for _, f := range bodyNormalizers[serviceID] {
+		f(operation, v1)
+		f(operation, v2)
+	}
+
+	return reflect.DeepEqual(v1, v2)
+}
+
+func decodeJSONBody(b []byte) (map[string]any, error) {
+	var v any
+	if err := tfjson.DecodeFromBytes(b, &v); err != nil {
+		return nil, err
+	}
+
+	return asBody(v)
+}
+
+// decodeFormBody decodes an AWS Query or EC2 protocol request body with DecodeForm.
+func decodeFormBody(b []byte) (map[string]any, error) {
+	values, err := url.ParseQuery(string(b))
+	if err != nil {
+		return nil, err
+	}
+
+	return asBody(DecodeForm(values))
+}
+
+// xmlList is a list of repeated XML elements.
+type xmlList []any
+
+// decodeXMLBody decodes an AWS REST-XML protocol request body.
+// Elements are decoded into nested maps keyed by local name, repeated elements are gathered into lists
+// and leaf elements are decoded as their trimmed text. Attributes, including namespace declarations, are ignored.
+// For example, "<A><B>x</B><C>y</C><C>z</C></A>" decodes to {"A": {"B": "x", "C": ["y", "z"]}}.
+func decodeXMLBody(b []byte) (map[string]any, error) {
+	type element struct {
+		name     string
+		children map[string]any
+		text     strings.Builder
+	}
+
+	root := &element{children: make(map[string]any)}
+	stack := []*element{root}
+
+	d := xml.NewDecoder(bytes.NewReader(b))
+	for {
+		token, err := d.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch token := token.(type) {
+		case xml.StartElement:
+			stack = append(stack, &element{name: token.Name.Local, children: make(map[string]any)})
+
+		case xml.EndElement:
+			e := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+
+			var value any = e.children
+			if len(e.children) == 0 {
+				value = strings.TrimSpace(e.text.String())
+			}
+
+			parent := stack[len(stack)-1].children
+			switch v := parent[e.name].(type) {
+			case nil:
+				parent[e.name] = value
+			case xmlList:
+				parent[e.name] = append(v, value)
+			default:
+				parent[e.name] = xmlList{v, value}
+			}
+
+		case xml.CharData:
+			stack[len(stack)-1].text.Write(token)
+		}
+	}
+
+	return asBody(canonicalizeXML(root.children))
+}
+
+// canonicalizeXML converts the lists of repeated elements in a decoded XML body to []any.
+func canonicalizeXML(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, e := range v {
+			v[k] = canonicalizeXML(e)
+		}
+		return v
+	case xmlList:
+		l := make([]any, len(v))
+		for i, e := range v {
+			l[i] = canonicalizeXML(e)
+		}
+		return l
+	default:
+		return v
+	}
+}
+
+// decodeCBORBody decodes an AWS RPC v2 CBOR protocol request body.
+func decodeCBORBody(b []byte) (map[string]any, error) {
+	v, err := cbor.Decode(b)
+	if err != nil {
+		return nil, err
+	}
+
+	return asBody(fromCBOR(v))
+}
+
+func fromCBOR(v cbor.Value) any {
+	switch v := v.(type) {
+	case cbor.Uint:
+		return float64(v)
+	case cbor.NegInt:
+		return -float64(v)
+	case cbor.Slice:
+		return []byte(v)
+	case cbor.String:
+		return string(v)
+	case cbor.List:
+		l := make([]any, len(v))
+		for i, v := range v {
+			l[i] = fromCBOR(v)
+		}
+		return l
+	case cbor.Map:
+		m := make(map[string]any, len(v))
+		for k, v := range v {
+			m[k] = fromCBOR(v)
+		}
+		return m
+	case *cbor.Tag:
+		// Tagged values (e.g. epoch-seconds timestamps) are compared by their content.
+		return fromCBOR(v.Value)
+	case cbor.Bool:
+		return bool(v)
+	case cbor.Float32:
+		return float64(v)
+	case cbor.Float64:
+		return float64(v)
+	default: // *cbor.Nil, *cbor.Undefined.
+		return nil
+	}
+}
+
+func asBody(v any) (map[string]any, error) {
+	switch v := v.(type) {
+	case map[string]any:
+		return v, nil
+	case nil:
+		return make(map[string]any), nil
+	default:
+		return nil, fmt.Errorf("unexpected request body type: %T", v)
+	}
+}
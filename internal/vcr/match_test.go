@@ -0,0 +1,190 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/encoding/cbor"
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
+)
+
+func TestRequestBodiesMatch(t *testing.T) {
+	vcr.RegisterBodyNormalizer("TestService", vcr.RemoveBodyFields("ClientToken"))
+	vcr.RegisterBodyNormalizer("TestService", vcr.SortBodyLists("Tags", "Filter", "Filter.Value"))
+
+	t.Parallel()
+
+	testCases := []struct {
+		testName    string
+		serviceID   string
+		contentType string
+		x, y        string
+		wantMatch   bool
+	}{
+		{
+			testName:    "unsupported content type",
+			contentType: "text/plain",
+			x:           `a`,
+			y:           `b`,
+		},
+		{
+			testName:    "JSON reordered",
+			contentType: "application/x-amz-json-1.1",
+			x:           `{"A": "test1", "B": 42}`,
+			y:           `{"B": 42, "A": "test1"}`,
+			wantMatch:   true,
+		},
+		{
+			testName:    "JSON list order is significant",
+			contentType: "application/x-amz-json-1.1",
+			x:           `{"A": ["test1", "test2"]}`,
+			y:           `{"A": ["test2", "test1"]}`,
+		},
+		{
+			testName:    "JSON normalized",
+			serviceID:   "TestService",
+			contentType: "application/x-amz-json-1.0",
+			x:           `{"A": "test1", "ClientToken": "abc"}`,
+			y:           `{"A": "test1", "ClientToken": "def"}`,
+			wantMatch:   true,
+		},
+		{
+			testName:    "form reordered",
+			contentType: "application/x-www-form-urlencoded",
+			x:           `Action=CreateRole&Version=2010-05-08&RoleName=test`,
+			y:           `RoleName=test&Action=CreateRole&Version=2010-05-08`,
+			wantMatch:   true,
+		},
+		{
+			testName:    "form not equal",
+			contentType: "application/x-www-form-urlencoded",
+			x:           `Action=CreateRole&Version=2010-05-08&RoleName=test1`,
+			y:           `Action=CreateRole&Version=2010-05-08&RoleName=test2`,
+		},
+		{
+			testName:    "form member list order is significant",
+			contentType: "application/x-www-form-urlencoded; charset=utf-8",
+			x:           `Action=TagRole&Tags.member.1.Key=k1&Tags.member.1.Value=v1&Tags.member.2.Key=k2&Tags.member.2.Value=v2`,
+			y:           `Action=TagRole&Tags.member.1.Key=k2&Tags.member.1.Value=v2&Tags.member.2.Key=k1&Tags.member.2.Value=v1`,
+		},
+		{
+			testName:    "form member list sorted",
+			serviceID:   "TestService",
+			contentType: "application/x-www-form-urlencoded; charset=utf-8",
+			x:           `Action=TagRole&Tags.member.1.Key=k1&Tags.member.1.Value=v1&Tags.member.2.Key=k2&Tags.member.2.Value=v2`,
+			y:           `Action=TagRole&Tags.member.1.Key=k2&Tags.member.1.Value=v2&Tags.member.2.Key=k1&Tags.member.2.Value=v1`,
+			wantMatch:   true,
+		},
+		{
+			testName:    "form member list not equal",
+			contentType: "application/x-www-form-urlencoded",
+			x:           `Action=TagRole&Tags.member.1.Key=k1&Tags.member.1.Value=v1&Tags.member.2.Key=k2&Tags.member.2.Value=v2`,
+			y:           `Action=TagRole&Tags.member.1.Key=k1&Tags.member.1.Value=v2&Tags.member.2.Key=k2&Tags.member.2.Value=v1`,
+		},
+		{
+			testName:    "EC2 form numbered list order is significant",
+			contentType: "application/x-www-form-urlencoded",
+			x:           `Action=DescribeSubnets&Filter.1.Name=vpc-id&Filter.1.Value.1=vpc-1&Filter.2.Name=state&Filter.2.Value.1=available&Filter.2.Value.2=pending`,
+			y:           `Action=DescribeSubnets&Filter.2.Name=vpc-id&Filter.2.Value.1=vpc-1&Filter.1.Name=state&Filter.1.Value.2=available&Filter.1.Value.1=pending`,
+		},
+		{
+			testName:    "EC2 form numbered list sorted",
+			serviceID:   "TestService",
+			contentType: "application/x-www-form-urlencoded",
+			x:           `Action=DescribeSubnets&Filter.1.Name=vpc-id&Filter.1.Value.1=vpc-1&Filter.2.Name=state&Filter.2.Value.1=available&Filter.2.Value.2=pending`,
+			y:           `Action=DescribeSubnets&Filter.2.Name=vpc-id&Filter.2.Value.1=vpc-1&Filter.1.Name=state&Filter.1.Value.2=available&Filter.1.Value.1=pending`,
+			wantMatch:   true,
+		},
+		{
+			testName:    "form normalized",
+			serviceID:   "TestService",
+			contentType: "application/x-www-form-urlencoded",
+			x:           `Action=RunInstances&ClientToken=abc&MaxCount=1`,
+			y:           `Action=RunInstances&ClientToken=def&MaxCount=1`,
+			wantMatch:   true,
+		},
+		{
+			testName:    "form not normalized",
+			contentType: "application/x-www-form-urlencoded",
+			x:           `Action=RunInstances&ClientToken=abc&MaxCount=1`,
+			y:           `Action=RunInstances&ClientToken=def&MaxCount=1`,
+		},
+		{
+			testName:    "XML reordered",
+			contentType: "application/xml",
+			x:           `<CreateBucketConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><LocationConstraint>us-west-2</LocationConstraint><Tags><Tag>a</Tag><Tag>b</Tag></Tags></CreateBucketConfiguration>`,
+			y:           `<CreateBucketConfiguration><Tags><Tag>a</Tag><Tag>b</Tag></Tags><LocationConstraint>us-west-2</LocationConstraint></CreateBucketConfiguration>`,
+			wantMatch:   true,
+		},
+		{
+			testName:    "XML not equal",
+			contentType: "application/xml",
+			x:           `<CreateBucketConfiguration><LocationConstraint>us-west-2</LocationConstraint></CreateBucketConfiguration>`,
+			y:           `<CreateBucketConfiguration><LocationConstraint>us-east-2</LocationConstraint></CreateBucketConfiguration>`,
+		},
+		{
+			testName:    "XML list order is significant",
+			contentType: "application/xml",
+			x:           `<Tags><Tag>a</Tag><Tag>b</Tag></Tags>`,
+			y:           `<Tags><Tag>b</Tag><Tag>a</Tag></Tags>`,
+		},
+		{
+			testName:    "XML invalid",
+			contentType: "application/xml",
+			x:           `<A>`,
+			y:           `<A></A>`,
+		},
+		{
+			testName:    "CBOR equal",
+			contentType: "application/cbor",
+			x:           string(cbor.Encode(cbor.Map{"A": cbor.String("test1"), "B": cbor.NegInt(42), "C": cbor.List{cbor.Bool(true)}})),
+			y:           string(cbor.Encode(cbor.Map{"C": cbor.List{cbor.Bool(true)}, "B": cbor.NegInt(42), "A": cbor.String("test1")})),
+			wantMatch:   true,
+		},
+		{
+			testName:    "CBOR not equal",
+			contentType: "application/cbor",
+			x:           string(cbor.Encode(cbor.Map{"A": cbor.String("test1"), "B": cbor.Uint(42)})),
+			y:           string(cbor.Encode(cbor.Map{"A": cbor.String("test1"), "B": cbor.NegInt(42)})),
+		},
+		{
+			testName:    "CBOR normalized",
+			serviceID:   "TestService",
+			contentType: "application/cbor",
+			x:           string(cbor.Encode(cbor.Map{"A": cbor.String("test1"), "ClientToken": cbor.String("abc")})),
+			y:           string(cbor.Encode(cbor.Map{"A": cbor.String("test1"), "ClientToken": cbor.String("def")})),
+			wantMatch:   true,
+		},
+		{
+			testName:    "CBOR invalid",
+			contentType: "application/cbor",
+			x:           `{}`,
+			y:           string(cbor.Encode(cbor.Map{})),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			if testCase.serviceID != "" {
+				ctx = awsmiddleware.SetServiceID(ctx, testCase.serviceID)
+			}
+			r, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://example.com", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			r.Header.Set("Content-Type", testCase.contentType)
+
+			if got, want := vcr.RequestBodiesMatch(ctx, r, testCase.x, testCase.y), testCase.wantMatch; got != want {
+				t.Errorf("RequestBodiesMatch(%q, %q) = %v, want %v", testCase.x, testCase.y, got, want)
+			}
+		})
+	}
+}
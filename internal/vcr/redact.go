@@ -0,0 +1,450 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/aws/smithy-go/encoding/cbor"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/cassette"
+)
+
+const (
+	// redactedValuePrefix is the prefix of the placeholders substituted for sensitive values.
+	redactedValuePrefix = "REDACTED-"
+)
+
+// redactionRule is a compiled redaction rule.
+type redactionRule []redactionStep
+
+type redactionStep struct {
+	name       string // Field or element name, "*" matches any name.
+	descendant bool   // Whether the step matches at any depth below the previous step.
+}
+
+var redactionRules map[string]map[string]redactionRule
+
+// RegisterRedactionRules registers rules identifying sensitive values in the specified service's request and response bodies.
+// serviceID is the AWS SDK for Go v2 service ID, e.g. names.IAMServiceID.
+//
+// Each rule is either a JSONPath expression, e.g. "$.Credentials.SecretAccessKey" or "$..SecretString",
+// or an XPath expression, e.g. "/CreateAccessKeyResponse/CreateAccessKeyResult/AccessKey/SecretAccessKey" or "//SecretAccessKey".
+// Only child (".", "/"), descendant ("..", "//") and wildcard ("*") steps are supported.
+// List members are traversed implicitly, so "$.Tags[*].Value" and "$.Tags.Value" are equivalent.
+// Rules apply to all body encodings. For XML bodies the path includes the document element,
+// and for Query protocol (form-encoded) bodies the path is the parameter name without list indices.
+//
+// Registration is expected to take place in a service package's test init function. An invalid rule causes a panic.
+func RegisterRedactionRules(serviceID string, rules ...string) {
+	if redactionRules == nil {
+		redactionRules = make(map[string]map[string]redactionRule)
+	}
+
+	for _, rule := range rules {
+		r, err := parseRedactionRule(rule)
+		if err != nil {
+			panic(err) //lintignore:R009
+		}
+
+		if redactionRules[serviceID] == nil {
+			redactionRules[serviceID] = make(map[string]redactionRule)
+		}
+		redactionRules[serviceID][rule] = r
+	}
+}
+
+func parseRedactionRule(s string) (redactionRule, error) {
+	var rule redactionRule
+
+	switch {
+	case strings.HasPrefix(s, "$"):
+		for rest := s[1:]; rest != ""; {
+			var step redactionStep
+
+			switch {
+			case strings.HasPrefix(rest, "[*]"):
+				rest = rest[3:]
+				continue
+			case strings.HasPrefix(rest, ".."):
+				step.descendant = true
+				rest = rest[2:]
+			case strings.HasPrefix(rest, "."):
+				rest = rest[1:]
+			default:
+				return nil, fmt.Errorf("invalid JSONPath redaction rule (%s)", s)
+			}
+
+			i := strings.IndexAny(rest, ".[")
+			if i == -1 {
+				i = len(rest)
+			}
+			if step.name, rest = rest[:i], rest[i:]; step.name == "" {
+				return nil, fmt.Errorf("invalid JSONPath redaction rule (%s)", s)
+			}
+
+			rule = append(rule, step)
+		}
+
+	case strings.HasPrefix(s, "/"):
+		for rest := s; rest != ""; {
+			var step redactionStep
+
+			if strings.HasPrefix(rest, "//") {
+				step.descendant = true
+				rest = rest[2:]
+			} else {
+				rest = rest[1:]
+			}
+
+			i := strings.Index(rest, "/")
+			if i == -1 {
+				i = len(rest)
+			}
+			if step.name, rest = rest[:i], rest[i:]; step.name == "" {
+				return nil, fmt.Errorf("invalid XPath redaction rule (%s)", s)
+			}
+
+			rule = append(rule, step)
+		}
+
+	default:
+		return nil, fmt.Errorf("redaction rule (%s) is neither a JSONPath nor an XPath expression", s)
+	}
+
+	if len(rule) == 0 {
+		return nil, fmt.Errorf("empty redaction rule (%s)", s)
+	}
+
+	return rule, nil
+}
+
+// matches returns whether the rule matches the specified path.
+func (r redactionRule) matches(path []string) bool {
+	if len(r) == 0 {
+		return len(path) == 0
+	}
+
+	step := r[0]
+	if !step.descendant {
+		return len(path) > 0 && step.matches(path[0]) && r[1:].matches(path[1:])
+	}
+
+	for i := range path {
+		if step.matches(path[i]) && r[1:].matches(path[i+1:]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s redactionStep) matches(name string) bool {
+	return s.name == "*" || s.name == name
+}
+
+func matchesAny(rules map[string]redactionRule, path []string) bool {
+	for _, rule := range rules {
+		if rule.matches(path) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RedactInteraction replaces sensitive values in a recorded interaction's request and response bodies
+// with stable placeholders, using the rules registered for the specified service.
+func RedactInteraction(serviceID string, i *cassette.Interaction) error {
+	mediaType, _, _ := mime.ParseMediaType(i.Request.Headers.Get("Content-Type"))
+	if body, ok, err := redactBody(serviceID, mediaType, i.Request.Body); err != nil {
+		return fmt.Errorf("redacting %s request body: %w", serviceID, err)
+	} else if ok {
+		i.Request.Body = body
+		i.Request.ContentLength = int64(len(body))
+		if i.Request.Form != nil {
+			if i.Request.Form, err = url.ParseQuery(body); err != nil {
+				return fmt.Errorf("redacting %s request form: %w", serviceID, err)
+			}
+		}
+	}
+
+	mediaType, _, _ = mime.ParseMediaType(i.Response.Headers.Get("Content-Type"))
+	if body, ok, err := redactBody(serviceID, mediaType, i.Response.Body); err != nil {
+		return fmt.Errorf("redacting %s response body: %w", serviceID, err)
+	} else if ok {
+		i.Response.Body = body
+		i.Response.ContentLength = int64(len(body))
+		if i.Response.Headers.Get("Content-Length") != "" {
+			i.Response.Headers.Set("Content-Length", strconv.Itoa(len(body)))
+		}
+	}
+
+	return nil
+}
+
+// redactBody returns the body with sensitive values replaced and whether any value was replaced.
+func redactBody(serviceID, mediaType, body string) (string, bool, error) {
+	rules := redactionRules[serviceID]
+	if len(rules) == 0 || body == "" {
+		return body, false, nil
+	}
+
+	switch mediaType {
+	case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
+		var v any
+		if err := tfjson.DecodeFromString(body, &v); err != nil {
+			return "", false, err
+		}
+
+		if !redactJSON(v, nil, rules, false) {
+			return body, false, nil
+		}
+
+		s, err := tfjson.EncodeToString(v)
+		if err != nil {
+			return "", false, err
+		}
+
+		return strings.TrimSuffix(s, "\n"), true, nil
+
+	case "application/x-www-form-urlencoded":
+		values, err := url.ParseQuery(body)
+		if err != nil {
+			return "", false, err
+		}
+
+		var changed bool
+		for k, v := range values {
+			if !matchesAny(rules, formPath(k)) {
+				continue
+			}
+
+			for i := range v {
+				var ok bool
+				if v[i], ok = redactedValue(v[i]); ok {
+					changed = true
+				}
+			}
+		}
+
+		if !changed {
+			return body, false, nil
+		}
+
+		return values.Encode(), true, nil
+
+	case "application/cbor":
+		v, err := cbor.Decode([]byte(body))
+		if err != nil {
+			return "", false, err
+		}
+
+		v, changed := redactCBOR(v, nil, rules, false)
+		if !changed {
+			return body, false, nil
+		}
+
+		return string(cbor.Encode(v)), true, nil
+
+	case "application/xml", "text/xml":
+		return redactXML(body, rules)
+	}
+
+	return body, false, nil
+}
+
+// formPath returns the path of a Query or EC2 protocol parameter, omitting list indices.
+func formPath(key string) []string {
+	return slices.DeleteFunc(strings.Split(key, "."), func(s string) bool {
+		if s == "member" || s == "entry" {
+			return true
+		}
+		_, err := strconv.Atoi(s)
+		return err == nil
+	})
+}
+
+func redactJSON(v any, path []string, rules map[string]redactionRule, redact bool) bool {
+	var changed bool
+
+	switch v := v.(type) {
+	case map[string]any:
+		for k, e := range v {
+			path := append(slices.Clip(path), k)
+			if s, ok := e.(string); ok {
+				if redact || matchesAny(rules, path) {
+					var ok bool
+					if v[k], ok = redactedValue(s); ok {
+						changed = true
+					}
+				}
+			} else if redactJSON(e, path, rules, redact || matchesAny(rules, path)) {
+				changed = true
+			}
+		}
+
+	case []any:
+		for i, e := range v {
+			if s, ok := e.(string); ok {
+				if redact {
+					var ok bool
+					if v[i], ok = redactedValue(s); ok {
+						changed = true
+					}
+				}
+			} else if redactJSON(e, path, rules, redact) {
+				changed = true
+			}
+		}
+	}
+
+	return changed
+}
+
+func redactCBOR(v cbor.Value, path []string, rules map[string]redactionRule, redact bool) (cbor.Value, bool) {
+	var changed bool
+
+	switch v := v.(type) {
+	case cbor.Map:
+		for k, e := range v {
+			path := append(slices.Clip(path), k)
+			var ok bool
+			if v[k], ok = redactCBOR(e, path, rules, redact || matchesAny(rules, path)); ok {
+				changed = true
+			}
+		}
+		return v, changed
+
+	case cbor.List:
+		for i, e := range v {
+			var ok bool
+			if v[i], ok = redactCBOR(e, path, rules, redact); ok {
+				changed = true
+			}
+		}
+		return v, changed
+
+	case *cbor.Tag:
+		var ok bool
+		v.Value, ok = redactCBOR(v.Value, path, rules, redact)
+		return v, ok
+
+	case cbor.String:
+		if redact {
+			s, ok := redactedValue(string(v))
+			return cbor.String(s), ok
+		}
+
+	case cbor.Slice:
+		if redact {
+			s, ok := redactedValue(string(v))
+			return cbor.Slice(s), ok
+		}
+	}
+
+	return v, false
+}
+
+// redactXML replaces the character data of matching elements, leaving the rest of the document untouched.
+func redactXML(body string, rules map[string]redactionRule) (string, bool, error) {
+	type span struct {
+		start, end int64
+		value      string
+	}
+
+	var (
+		path   []string
+		redact []bool
+		spans  []span
+	)
+
+	d := xml.NewDecoder(strings.NewReader(body))
+	for {
+		start := d.InputOffset()
+		token, err := d.RawToken()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return "", false, err
+		}
+
+		switch token := token.(type) {
+		case xml.StartElement:
+			path = append(path, token.Name.Local)
+			redact = append(redact, (len(redact) > 0 && redact[len(redact)-1]) || matchesAny(rules, path))
+
+		case xml.EndElement:
+			if n := len(path); n > 0 {
+				path, redact = path[:n-1], redact[:n-1]
+			}
+
+		case xml.CharData:
+			if n := len(redact); n > 0 && redact[n-1] && len(bytes.TrimSpace(token)) > 0 {
+				if v, ok := redactedValue(string(token)); ok {
+					spans = append(spans, span{start: start, end: d.InputOffset(), value: v})
+				}
+			}
+		}
+	}
+
+	if len(spans) == 0 {
+		return body, false, nil
+	}
+
+	var sb strings.Builder
+	var offset int64
+	for _, span := range spans {
+		sb.WriteString(body[offset:span.start])
+		xml.EscapeText(&sb, []byte(span.value))
+		offset = span.end
+	}
+	sb.WriteString(body[offset:])
+
+	return sb.String(), true, nil
+}
+
+// redactedValue returns a stable placeholder for the specified sensitive value and whether the value was replaced.
+// The placeholder is derived from a hash of the value so that equal values have equal placeholders,
+// and is itself base64 encoded if the value appears to be a base64 encoded blob.
+// Empty values and values that have already been redacted are returned unchanged.
+func redactedValue(s string) (string, bool) {
+	if s == "" || isRedactedValue(s) {
+		return s, false
+	}
+
+	sum := sha256.Sum256([]byte(s))
+	v := redactedValuePrefix + hex.EncodeToString(sum[:8])
+
+	if _, err := base64.StdEncoding.DecodeString(s); err == nil {
+		v = base64.StdEncoding.EncodeToString([]byte(v))
+	}
+
+	return v, true
+}
+
+func isRedactedValue(s string) bool {
+	if strings.HasPrefix(s, redactedValuePrefix) {
+		return true
+	}
+
+	if b, err := base64.StdEncoding.DecodeString(s); err == nil && bytes.HasPrefix(b, []byte(redactedValuePrefix)) {
+		return true
+	}
+
+	return false
+}
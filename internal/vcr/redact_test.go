@@ -0,0 +1,269 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr_test
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/encoding/cbor"
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/cassette"
+)
+
+func TestRedactInteraction(t *testing.T) {
+	vcr.RegisterRedactionRules("TestRedact", "$..SecretString", "$.Credentials.SecretAccessKey", "//AccessKey/SecretAccessKey", "$.MasterUserPassword", "$.Items[*].Secret")
+
+	t.Parallel()
+
+	testCases := []struct {
+		testName         string
+		serviceID        string
+		contentType      string
+		body             string
+		wantRedacted     bool
+		wantContains     []string
+		wantNotContains  []string
+		wantResponseOnly bool
+	}{
+		{
+			testName:     "no rules",
+			serviceID:    "TestRedactNone",
+			contentType:  "application/x-amz-json-1.1",
+			body:         `{"SecretString": "s3cr3t"}`,
+			wantContains: []string{`s3cr3t`},
+		},
+		{
+			testName:        "JSON descendant",
+			serviceID:       "TestRedact",
+			contentType:     "application/x-amz-json-1.1",
+			body:            `{"Name": "test", "Secret": {"SecretString": "s3cr3t"}}`,
+			wantRedacted:    true,
+			wantContains:    []string{`"Name":"test"`, `REDACTED-`},
+			wantNotContains: []string{`s3cr3t`},
+		},
+		{
+			testName:        "JSON child",
+			serviceID:       "TestRedact",
+			contentType:     "application/x-amz-json-1.1",
+			body:            `{"Credentials": {"AccessKeyId": "AKIA", "SecretAccessKey": "s3cr3t!"}}`,
+			wantRedacted:    true,
+			wantContains:    []string{`"AccessKeyId":"AKIA"`},
+			wantNotContains: []string{`s3cr3t!`},
+		},
+		{
+			testName:     "JSON child not at root",
+			serviceID:    "TestRedact",
+			contentType:  "application/x-amz-json-1.1",
+			body:         `{"Other": {"Credentials": {"SecretAccessKey": "s3cr3t!"}}}`,
+			wantContains: []string{`s3cr3t!`},
+		},
+		{
+			testName:        "JSON list",
+			serviceID:       "TestRedact",
+			contentType:     "application/x-amz-json-1.0",
+			body:            `{"Items": [{"Name": "a", "Secret": "s3cr3t1!"}, {"Name": "b", "Secret": "s3cr3t2!"}]}`,
+			wantRedacted:    true,
+			wantContains:    []string{`"Name":"a"`, `"Name":"b"`},
+			wantNotContains: []string{`s3cr3t1!`, `s3cr3t2!`},
+		},
+		{
+			testName:        "form",
+			serviceID:       "TestRedact",
+			contentType:     "application/x-www-form-urlencoded; charset=utf-8",
+			body:            `Action=CreateDBInstance&MasterUserPassword=s3cr3t%21&DBInstanceIdentifier=test`,
+			wantRedacted:    true,
+			wantContains:    []string{`DBInstanceIdentifier=test`, `MasterUserPassword=REDACTED-`},
+			wantNotContains: []string{`s3cr3t`},
+		},
+		{
+			testName:        "form list",
+			serviceID:       "TestRedact",
+			contentType:     "application/x-www-form-urlencoded",
+			body:            `Action=Test&Items.member.1.Secret=s3cr3t1%21&Items.member.1.Name=a`,
+			wantRedacted:    true,
+			wantContains:    []string{`Items.member.1.Name=a`},
+			wantNotContains: []string{`s3cr3t1`},
+		},
+		{
+			testName:         "XML",
+			serviceID:        "TestRedact",
+			contentType:      "text/xml",
+			body:             `<CreateAccessKeyResponse xmlns="https://iam.amazonaws.com/doc/2010-05-08/"><CreateAccessKeyResult><AccessKey><AccessKeyId>AKIA</AccessKeyId><SecretAccessKey>s3cr3t&amp;!</SecretAccessKey></AccessKey></CreateAccessKeyResult></CreateAccessKeyResponse>`,
+			wantRedacted:     true,
+			wantContains:     []string{`<AccessKeyId>AKIA</AccessKeyId>`, `<SecretAccessKey>REDACTED-`, `xmlns="https://iam.amazonaws.com/doc/2010-05-08/"`},
+			wantNotContains:  []string{`s3cr3t`},
+			wantResponseOnly: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			headers := http.Header{}
+			headers.Set("Content-Type", testCase.contentType)
+			headers.Set("Content-Length", "1")
+			i := &cassette.Interaction{
+				Request: cassette.Request{
+					Body:    testCase.body,
+					Headers: headers,
+				},
+				Response: cassette.Response{
+					Body:    testCase.body,
+					Headers: headers.Clone(),
+				},
+			}
+			if testCase.wantResponseOnly {
+				i.Request.Body = ""
+			}
+
+			if err := vcr.RedactInteraction(testCase.serviceID, i); err != nil {
+				t.Fatalf("RedactInteraction: %s", err)
+			}
+
+			bodies := []string{i.Response.Body}
+			if !testCase.wantResponseOnly {
+				bodies = append(bodies, i.Request.Body)
+			}
+
+			for _, body := range bodies {
+				if got, want := body != testCase.body, testCase.wantRedacted; got != want {
+					t.Errorf("redacted = %v, want %v: %s", got, want, body)
+				}
+				for _, s := range testCase.wantContains {
+					if !strings.Contains(body, s) {
+						t.Errorf("%q does not contain %q", body, s)
+					}
+				}
+				for _, s := range testCase.wantNotContains {
+					if strings.Contains(body, s) {
+						t.Errorf("%q contains %q", body, s)
+					}
+				}
+			}
+
+			if testCase.wantRedacted {
+				if got, want := i.Response.ContentLength, int64(len(i.Response.Body)); got != want {
+					t.Errorf("ContentLength = %d, want %d", got, want)
+				}
+
+				// Redaction is idempotent.
+				body := i.Response.Body
+				if err := vcr.RedactInteraction(testCase.serviceID, i); err != nil {
+					t.Fatalf("RedactInteraction: %s", err)
+				}
+				if got, want := i.Response.Body, body; got != want {
+					t.Errorf("second redaction = %q, want %q", got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestRedactInteraction_CBOR(t *testing.T) {
+	vcr.RegisterRedactionRules("TestRedactCBOR", "$..SecretString")
+
+	t.Parallel()
+
+	body := string(cbor.Encode(cbor.Map{"Name": cbor.String("test"), "SecretString": cbor.String("s3cr3t")}))
+	headers := http.Header{}
+	headers.Set("Content-Type", "application/cbor")
+	i := &cassette.Interaction{
+		Request:  cassette.Request{Headers: headers},
+		Response: cassette.Response{Body: body, Headers: headers},
+	}
+
+	if err := vcr.RedactInteraction("TestRedactCBOR", i); err != nil {
+		t.Fatalf("RedactInteraction: %s", err)
+	}
+
+	v, err := cbor.Decode([]byte(i.Response.Body))
+	if err != nil {
+		t.Fatalf("decoding redacted body: %s", err)
+	}
+	m := v.(cbor.Map)
+	if got, want := m["Name"], cbor.String("test"); got != want {
+		t.Errorf("Name = %v, want %v", got, want)
+	}
+	if got := string(m["SecretString"].(cbor.String)); !strings.HasPrefix(got, "REDACTED-") {
+		t.Errorf("SecretString = %q, want placeholder", got)
+	}
+}
+
+func TestRequestBodiesMatch_redacted(t *testing.T) {
+	const serviceID = "TestRedactMatch"
+	vcr.RegisterRedactionRules(serviceID, "$..MasterUserPassword")
+
+	t.Parallel()
+
+	body := url.Values{
+		"Action":                       []string{"CreateDBCluster"},
+		"DBClusterIdentifier":          []string{"test"},
+		"MasterUserPassword":           []string{"s3cr3t!"},
+		"Tags.member.1.Key":            []string{"Name"},
+		"Tags.member.1.Value":          []string{"test"},
+		"VpcSecurityGroupIds.member.1": []string{"sg-1"},
+	}.Encode()
+
+	headers := http.Header{}
+	headers.Set("Content-Type", "application/x-www-form-urlencoded")
+	i := &cassette.Interaction{
+		Request: cassette.Request{Body: body, Headers: headers},
+	}
+	if err := vcr.RedactInteraction(serviceID, i); err != nil {
+		t.Fatalf("RedactInteraction: %s", err)
+	}
+
+	ctx := awsmiddleware.SetServiceID(context.Background(), serviceID)
+	r, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://example.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.Header = headers
+
+	if !vcr.RequestBodiesMatch(ctx, r, body, i.Request.Body) {
+		t.Errorf("RequestBodiesMatch(%q, %q) = false, want true", body, i.Request.Body)
+	}
+}
+
+func TestRequestBodiesMatch_redactedXML(t *testing.T) {
+	const serviceID = "TestRedactMatchXML"
+	vcr.RegisterRedactionRules(serviceID, "//HealthCheckConfig/Password")
+
+	t.Parallel()
+
+	body := `<CreateHealthCheckRequest xmlns="https://route53.amazonaws.com/doc/2013-04-01/"><CallerReference>ref</CallerReference><HealthCheckConfig><Password>s3cr3t!</Password><Port>443</Port></HealthCheckConfig></CreateHealthCheckRequest>`
+
+	headers := http.Header{}
+	headers.Set("Content-Type", "application/xml")
+	i := &cassette.Interaction{
+		Request: cassette.Request{Body: body, Headers: headers},
+	}
+	if err := vcr.RedactInteraction(serviceID, i); err != nil {
+		t.Fatalf("RedactInteraction: %s", err)
+	}
+	if i.Request.Body == body {
+		t.Fatalf("RedactInteraction did not redact %q", body)
+	}
+
+	ctx := awsmiddleware.SetServiceID(context.Background(), serviceID)
+	r, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://example.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.Header = headers
+
+	if !vcr.RequestBodiesMatch(ctx, r, body, i.Request.Body) {
+		t.Errorf("RequestBodiesMatch(%q, %q) = false, want true", body, i.Request.Body)
+	}
+
+	if other := strings.Replace(body, "s3cr3t!", "other", 1); vcr.RequestBodiesMatch(ctx, r, other, i.Request.Body) {
+		t.Errorf("RequestBodiesMatch(%q, %q) = true, want false", other, i.Request.Body)
+	}
+}
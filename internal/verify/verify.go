@@ -7,7 +7,11 @@ import (
 	tfyaml "github.com/hashicorp/terraform-provider-aws/internal/yaml"
 )
 
-const UUIDRegexPattern = `[0-9a-f]{8}-[0-9a-f]{4}-[1-5][0-9a-f]{3}-[ab89][0-9a-f]{3}-[0-9a-f]{12}`
+const (
+	// S3BucketNameRegexPattern matches general purpose S3 bucket names.
+	S3BucketNameRegexPattern = `[0-9a-z][0-9a-z.-]{1,61}[0-9a-z]`
+	UUIDRegexPattern         = `[0-9a-f]{8}-[0-9a-f]{4}-[1-5][0-9a-f]{3}-[ab89][0-9a-f]{3}-[0-9a-f]{12}`
+)
 
 // Takes a value containing YAML string and passes it through
 // the YAML parser. Returns either a parsing